@@ -0,0 +1,7 @@
+// Package blockchain holds the Cadence contracts of the app and the transaction and script templates that use them.
+package blockchain
+
+import "embed"
+
+//go:embed contracts transactions
+var Assets embed.FS
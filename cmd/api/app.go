@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	"cloud.google.com/go/logging"
@@ -14,8 +15,13 @@ import (
 	"github.com/gin-gonic/gin"
 	cors "github.com/itsjamie/gin-cors"
 	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/cmd/certificates"
 	"github.com/manubidegain/piggy-api/cmd/entities"
+	handler "github.com/manubidegain/piggy-api/cmd/handlers"
+	"github.com/manubidegain/piggy-api/cmd/indexer"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
 	"github.com/manubidegain/piggy-api/firebase"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
 	"github.com/manubidegain/piggy-api/utils"
 	"github.com/stripe/stripe-go/client"
 
@@ -33,8 +39,19 @@ type App struct {
 	Profile       string
 	Logger        *log.Logger
 	ProjectConfig *configuration.ProjectConfig
+	Jobs          *jobs.Queue
+	Certificates  *certificates.Renderer
+
+	// StripeWebhookSecret verifies the signature of the Stripe events, read once at startup
+	StripeWebhookSecret string
 }
 
+// Number of workers running blockchain jobs
+const jobWorkers = 4
+
+// Environment variable holding the signing secret of the Stripe webhook endpoint
+const stripeWebhookSecretEnv = "STRIPE_WEBHOOK_SECRET"
+
 // App initialize with predefined configuration
 func (a *App) Initialize() {
 	port := os.Getenv("PORT")
@@ -48,6 +65,12 @@ func (a *App) Initialize() {
 	a.Config = configuration.BuildConfig(a.Profile)
 	a.StripeClient = *utils.SetupStripe()
 	a.FlowConfig = configuration.ReadFlowConfig()
+	if a.embeddedEmulator() {
+		// the contracts are deployed on start, the chain and the custodial keys are lost on exit
+		if _, err := flowUtils.StartEmbeddedEmulator(context.Background()); err != nil {
+			log.Fatalf("Failed to start the embedded emulator: %v", err)
+		}
+	}
 
 	dbURI := getDataBaseURI(a.Config, a.Profile)
 
@@ -58,6 +81,11 @@ func (a *App) Initialize() {
 		log.Fatal("Could not connect database")
 	}
 	a.DB = DBMigrate(db)
+	if a.embeddedEmulator() {
+		if err := resetChainData(a.DB); err != nil {
+			log.Fatalf("Failed to reset the data of the previous emulator chain: %v", err)
+		}
+	}
 
 	// initialize new gin engine (for server)
 	a.Router = gin.Default()
@@ -65,6 +93,12 @@ func (a *App) Initialize() {
 	useCorsMiddleware(public)
 	public.GET("/piggy", a.GetAllPiggies)
 	public.GET("/piggy/:piggy_id", a.GetPiggy)
+	// Stripe authenticates its calls with the request signature
+	a.StripeWebhookSecret = os.Getenv(stripeWebhookSecretEnv)
+	a.setWebhookRouters()
+	a.Certificates = certificates.New(certificatesDir(a.Config))
+	// wallets and marketplaces read the NFT metadata and images without credentials
+	a.setMetadataRouters()
 	// configure firebase
 	firebaseAuth := firebase.SetupFirebase()
 	a.AuthClient = firebaseAuth
@@ -82,6 +116,17 @@ func (a *App) Initialize() {
 	logName := "my-log"
 
 	a.Logger = client.Logger(logName).StandardLogger(logging.Info)
+
+	// start the workers that send blockchain transactions out of the request cycle
+	a.Jobs = jobs.NewQueue(a.DB, jobWorkers, a.Logger)
+	handler.RegisterJobHandlers(a.Jobs, a.DB, a.FlowConfig, a.Profile, a.Logger, a.ProjectConfig)
+	a.Jobs.Start(ctx)
+
+	// keep the piggies and donations in sync with the contract events
+	if err := a.startIndexer(ctx); err != nil {
+		log.Fatalf("Failed to start the event indexer: %v", err)
+	}
+
 	a.Router.Use(cors.Middleware(cors.Config{
 		Origins:         "*",
 		Methods:         "GET, PUT, POST, DELETE",
@@ -92,15 +137,12 @@ func (a *App) Initialize() {
 		ValidateHeaders: false,
 	}))
 
-	// set db & firebase auth to gin context with a middleware to all incoming request
-	if a.Profile != "dev" {
-		a.Router.Use(func(c *gin.Context) {
-			c.Set("firebaseAuth", firebaseAuth)
-		})
-		// using the auth middleware to validate api requests
-		a.Router.Use(firebase.AuthMiddleware)
-
+	// using the auth middleware to validate api requests, dev verifies the tokens of the token command
+	verifier, err := firebase.NewTokenVerifier(a.Config.Auth, a.Profile, firebaseAuth)
+	if err != nil {
+		log.Fatalf("Failed to create the token verifier: %v", err)
 	}
+	a.Router.Use(firebase.AuthMiddleware(verifier))
 	// setting routers
 
 	a.setRouters()
@@ -132,16 +174,70 @@ func getDataBaseURI(config *configuration.Config, profile string) string {
 }
 
 func DBMigrate(db *gorm.DB) *gorm.DB {
-	db.AutoMigrate(&entities.User{}, &entities.Piggy{}, &entities.Donation{})
+	db.AutoMigrate(&entities.User{}, &entities.Piggy{}, &entities.Donation{}, &entities.StripeEvent{}, &entities.Job{}, &entities.WalletChallenge{}, &entities.IndexerCheckpoint{}, &entities.Invitation{})
 	db.LogMode(true)
 	return db
 }
 
+func certificatesDir(config *configuration.Config) string {
+	if config.Certificates != nil && config.Certificates.CacheDir != "" {
+		return config.Certificates.CacheDir
+	}
+	return filepath.Join(os.TempDir(), "piggy-certificates")
+}
+
+func (a *App) startIndexer(ctx context.Context) error {
+	flowClient, err := utils.ConnectToFlow(a.Profile, a.FlowConfig)
+	if err != nil {
+		return err
+	}
+	var startHeight uint64
+	if a.Config.Indexer != nil {
+		startHeight = a.Config.Indexer.StartHeight
+	}
+	env := flowUtils.NewEnv(a.Profile, a.FlowConfig)
+	if a.embeddedEmulator() {
+		// the embedded chain starts over on every boot and never forks
+		indexer.New(a.DB, flowClient, env.PiggyAddress, 1, a.Logger).SkipConfirmations().Start(ctx)
+		return nil
+	}
+	indexer.New(a.DB, flowClient, env.PiggyAddress, startHeight, a.Logger).Start(ctx)
+	return nil
+}
+
+// embeddedEmulator reports whether the api runs the dev chain in its own process.
+func (a *App) embeddedEmulator() bool {
+	return a.Profile == utils.Development && a.Config.Emulator != nil && a.Config.Emulator.Embedded
+}
+
+// resetChainData forgets the previous chain of the embedded emulator, which starts over from its genesis
+// on every boot: the indexer checkpoint, the piggies and donations, and the accounts of the users,
+// whose custodial keys were lost on exit.
+func resetChainData(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := indexer.Reset(tx); err != nil {
+			return err
+		}
+		for _, table := range []interface{}{&entities.Donation{}, &entities.Piggy{}, &entities.WalletChallenge{}} {
+			if err := tx.Unscoped().Delete(table).Error; err != nil {
+				return err
+			}
+		}
+		accounts := map[string]interface{}{"flow_address": "", "external_wallet": false, "flow_address_verified": false}
+		return tx.Unscoped().Model(&entities.User{}).Updates(accounts).Error
+	})
+}
+
 // Set all required routers
 func (a *App) setRouters() {
 	a.setUserRouters()
 	a.setDonationRouters()
 	a.setPiggyRouters()
+	a.setInvitationRouters()
+	a.setWalletRouters()
+	a.setChainRouters()
+	a.setJobRouters()
+	a.setSupportRouters()
 }
 
 // Run the app on it's router
@@ -1,8 +1,6 @@
 package configuration
 
 import (
-	"encoding/json"
-	"fmt"
 	"log"
 	"os"
 
@@ -17,9 +15,45 @@ const (
 )
 
 type Config struct {
-	BaseURL string
-	DB      *DBConfig     `yaml:"data_base"`
-	Sender  *SenderConfig `yaml:"sender"`
+	BaseURL      string
+	DB           *DBConfig           `yaml:"data_base"`
+	Sender       *SenderConfig       `yaml:"sender"`
+	Signer       *SignerConfig       `yaml:"signer"`
+	Indexer      *IndexerConfig      `yaml:"indexer"`
+	Certificates *CertificatesConfig `yaml:"certificates"`
+	Emulator     *EmulatorConfig     `yaml:"emulator"`
+	Auth         *AuthConfig         `yaml:"auth"`
+}
+
+// AuthConfig selects the verifier of the request tokens. Verifier is firebase, the default,
+// or local, which verifies the tokens signed with LocalKey by the token command.
+type AuthConfig struct {
+	Verifier string `yaml:"verifier"`
+	LocalKey string `yaml:"local_key"`
+}
+
+// EmulatorConfig makes the dev profile run an emulator in the process instead of connecting to the Flow emulator.
+type EmulatorConfig struct {
+	Embedded bool `yaml:"embedded"`
+}
+
+// CertificatesConfig sets the directory the rendered donation certificates are cached in.
+type CertificatesConfig struct {
+	CacheDir string `yaml:"cache_dir"`
+}
+
+// IndexerConfig sets the first block height whose contract events are indexed.
+type IndexerConfig struct {
+	StartHeight uint64 `yaml:"start_height"`
+}
+
+// SignerConfig selects how the service account signs transactions.
+// Type is memory (the key in flow.json), keystore (an encrypted key file) or kms (a Cloud KMS key version).
+type SignerConfig struct {
+	Type          string `yaml:"type"`
+	HashAlgorithm string `yaml:"hash_algorithm"`
+	KeystorePath  string `yaml:"keystore_path"`
+	KMSKey        string `yaml:"kms_key"`
 }
 
 type SenderConfig struct {
@@ -63,50 +97,6 @@ func GetConfig(path string) *Config {
 	return &cfg
 }
 
-const flowConfigPath = "./flow.json"
-
-type FlowServiceAccount struct {
-	Address string `json:"address"`
-	Key     string `json:"key"`
-}
-
-type FlowConfig struct {
-	Accounts struct {
-		Emulator FlowServiceAccount `json:"emulator"`
-		Testnet  FlowServiceAccount `json:"testnet"`
-		Mainnet  FlowServiceAccount `json:"mainnet"`
-	} `json:"accounts"`
-	Contracts map[string]string `json:"contracts"`
-	Networks  struct {
-		Emulator string `json:"emulator"`
-		Testnet  string `json:"testnet"`
-		Mainnet  string `json:"mainnet"`
-	} `json:"networks"`
-}
-
-func ReadFlowConfig() *FlowConfig {
-	f, err := os.Open(flowConfigPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			fmt.Println("Needs to include flow config file")
-		} else {
-			fmt.Printf("Failed to load config from %s: %s\n", flowConfigPath, err.Error())
-		}
-
-		os.Exit(1)
-	}
-
-	var conf *FlowConfig
-	err = json.NewDecoder(f).Decode(&conf)
-	if err != nil {
-		msg := "error decoding flow.json, file properties and Config struct does not match, error: " + err.Error()
-		log.Printf("[package:configuration][method:GetFlowConfig]" + msg)
-		panic(msg)
-	}
-
-	return conf
-}
-
 type ProjectConfig struct {
 	ProjectID string      `yaml:"project_id"`
 	URLS      *URLSConfig `yaml:"urls"`
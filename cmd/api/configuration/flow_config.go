@@ -0,0 +1,309 @@
+package configuration
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const flowConfigPath = "./flow.json"
+
+// Networks of flow.json the profiles run on.
+const (
+	EmulatorNetwork = "emulator"
+	TestnetNetwork  = "testnet"
+	MainnetNetwork  = "mainnet"
+)
+
+// Types of the account keys in flow.json.
+const (
+	HexKey       = "hex"
+	GoogleKMSKey = "google-kms"
+)
+
+// ServiceContract is the contract whose deploying account signs the service transactions, since it holds the Admin.
+const ServiceContract = "PiggyBanks"
+
+// RequiredContracts are the contracts imported by the transactions, they need an address on every network.
+var RequiredContracts = []string{"FungibleToken", "FlowToken", "NonFungibleToken", "MetadataViews", ServiceContract}
+
+var addressPattern = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{1,16}$`)
+
+// FlowConfig is flow.json, in the schema of the Flow CLI.
+type FlowConfig struct {
+	Emulators   map[string]FlowEmulator                `json:"emulators"`
+	Contracts   map[string]FlowContract                `json:"contracts"`
+	Networks    map[string]FlowNetwork                 `json:"networks"`
+	Accounts    map[string]FlowAccount                 `json:"accounts"`
+	Deployments map[string]map[string][]FlowDeployment `json:"deployments"`
+}
+
+type FlowEmulator struct {
+	Port           int    `json:"port"`
+	ServiceAccount string `json:"serviceAccount"`
+}
+
+// FlowContract is the source of a contract and the addresses it already has on some networks.
+// It is written as the source path or as an object with source and aliases.
+type FlowContract struct {
+	Source  string            `json:"source"`
+	Aliases map[string]string `json:"aliases"`
+}
+
+func (c *FlowContract) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.Source); err == nil {
+		return nil
+	}
+	type contract FlowContract
+	return json.Unmarshal(data, (*contract)(c))
+}
+
+// FlowNetwork is the access node of a network, written as its host or as an object with host and key.
+type FlowNetwork struct {
+	Host string `json:"host"`
+	Key  string `json:"key"`
+}
+
+func (n *FlowNetwork) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &n.Host); err == nil {
+		return nil
+	}
+	type network FlowNetwork
+	return json.Unmarshal(data, (*network)(n))
+}
+
+type FlowAccount struct {
+	Address string         `json:"address"`
+	Key     FlowAccountKey `json:"key"`
+}
+
+// FlowAccountKey is written as the private key hex or as an object with the key type, hex or google-kms.
+type FlowAccountKey struct {
+	Type               string `json:"type"`
+	Index              int    `json:"index"`
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+	HashAlgorithm      string `json:"hashAlgorithm"`
+	PrivateKey         string `json:"privateKey"`
+	ResourceID         string `json:"resourceID"`
+}
+
+func (k *FlowAccountKey) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &k.PrivateKey); err == nil {
+		k.Type = HexKey
+		return nil
+	}
+	type key FlowAccountKey
+	if err := json.Unmarshal(data, (*key)(k)); err != nil {
+		return err
+	}
+	if k.Type == "" {
+		k.Type = HexKey
+	}
+	return nil
+}
+
+// FlowDeployment is a contract an account deploys, written as its name or as an object with name and args.
+type FlowDeployment struct {
+	Name string            `json:"name"`
+	Args []json.RawMessage `json:"args"`
+}
+
+func (d *FlowDeployment) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &d.Name); err == nil {
+		return nil
+	}
+	type deployment FlowDeployment
+	return json.Unmarshal(data, (*deployment)(d))
+}
+
+// NetworkName returns the network of flow.json profile runs on.
+func NetworkName(profile string) string {
+	switch profile {
+	case Production:
+		return MainnetNetwork
+	case Test:
+		return TestnetNetwork
+	default:
+		return EmulatorNetwork
+	}
+}
+
+// Host returns the access node of the network of profile.
+func (c *FlowConfig) Host(profile string) (string, error) {
+	network := NetworkName(profile)
+	if c.Networks[network].Host == "" {
+		return "", fmt.Errorf("flow.json has no host for network %s", network)
+	}
+	return c.Networks[network].Host, nil
+}
+
+// ContractAddress returns the address without 0x prefix of contract on the network of profile,
+// its alias on the network or else the address of the account deploying it.
+func (c *FlowConfig) ContractAddress(profile string, contract string) (string, error) {
+	network := NetworkName(profile)
+	if alias, ok := c.Contracts[contract].Aliases[network]; ok {
+		return strings.TrimPrefix(alias, "0x"), nil
+	}
+	if name, ok := c.deployer(network, contract); ok {
+		return strings.TrimPrefix(c.Accounts[name].Address, "0x"), nil
+	}
+	return "", fmt.Errorf("flow.json has no alias or deployment of %s on network %s", contract, network)
+}
+
+// ServiceAccount returns the account signing the service transactions on the network of profile:
+// the service account of the default emulator on the emulator network, the account deploying
+// ServiceContract on the others.
+func (c *FlowConfig) ServiceAccount(profile string) (FlowAccount, error) {
+	network := NetworkName(profile)
+	name, ok := "", false
+	if network == EmulatorNetwork && c.Emulators["default"].ServiceAccount != "" {
+		name, ok = c.Emulators["default"].ServiceAccount, true
+	} else {
+		name, ok = c.deployer(network, ServiceContract)
+	}
+	if !ok {
+		return FlowAccount{}, fmt.Errorf("flow.json has no account deploying %s on network %s", ServiceContract, network)
+	}
+	account, ok := c.Accounts[name]
+	if !ok {
+		return FlowAccount{}, fmt.Errorf("flow.json has no account %s", name)
+	}
+	return account, nil
+}
+
+func (c *FlowConfig) deployer(network string, contract string) (string, bool) {
+	for _, name := range sortedKeys(c.Deployments[network]) {
+		for _, deployment := range c.Deployments[network][name] {
+			if deployment.Name == contract {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Validate checks the references between the sections of flow.json, and that every profile
+// network has a host, the address of the required contracts and a service account with a key.
+func (c *FlowConfig) Validate() error {
+	problems := []string{}
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	for _, name := range sortedKeys(c.Accounts) {
+		account := c.Accounts[name]
+		if !addressPattern.MatchString(account.Address) {
+			report("accounts.%s: invalid address %q", name, account.Address)
+		}
+		switch account.Key.Type {
+		case HexKey:
+			if _, err := hex.DecodeString(strings.TrimPrefix(account.Key.PrivateKey, "0x")); err != nil || account.Key.PrivateKey == "" {
+				report("accounts.%s: key is not a hex private key", name)
+			}
+		case GoogleKMSKey:
+			if account.Key.ResourceID == "" {
+				report("accounts.%s: google-kms key has no resourceID", name)
+			}
+		default:
+			report("accounts.%s: unsupported key type %q", name, account.Key.Type)
+		}
+	}
+	for _, name := range sortedKeys(c.Contracts) {
+		for _, network := range sortedKeys(c.Contracts[name].Aliases) {
+			if _, ok := c.Networks[network]; !ok {
+				report("contracts.%s.aliases: unknown network %s", name, network)
+			}
+			if address := c.Contracts[name].Aliases[network]; !addressPattern.MatchString(address) {
+				report("contracts.%s.aliases.%s: invalid address %q", name, network, address)
+			}
+		}
+	}
+	for _, network := range sortedKeys(c.Deployments) {
+		if _, ok := c.Networks[network]; !ok {
+			report("deployments.%s: unknown network", network)
+		}
+		for _, name := range sortedKeys(c.Deployments[network]) {
+			if _, ok := c.Accounts[name]; !ok {
+				report("deployments.%s.%s: unknown account", network, name)
+			}
+			for _, deployment := range c.Deployments[network][name] {
+				if _, ok := c.Contracts[deployment.Name]; !ok {
+					report("deployments.%s.%s: unknown contract %s", network, name, deployment.Name)
+				}
+			}
+		}
+	}
+	for _, name := range sortedKeys(c.Emulators) {
+		if _, ok := c.Accounts[c.Emulators[name].ServiceAccount]; !ok {
+			report("emulators.%s: unknown service account %q", name, c.Emulators[name].ServiceAccount)
+		}
+	}
+
+	for _, profile := range []string{Development, Test, Production} {
+		network := NetworkName(profile)
+		if _, err := c.Host(profile); err != nil {
+			report("networks.%s: no host for the %s profile", network, profile)
+			continue
+		}
+		for _, contract := range RequiredContracts {
+			if _, err := c.ContractAddress(profile, contract); err != nil {
+				report("contracts.%s: no alias or deployment on network %s", contract, network)
+			}
+		}
+		if _, err := c.ServiceAccount(profile); err != nil {
+			report("deployments.%s: %s", network, err)
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New("invalid flow.json:\n\t" + strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+// LoadFlowConfig reads and validates the flow.json at path.
+func LoadFlowConfig(path string) (*FlowConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	conf := &FlowConfig{}
+	if err := json.NewDecoder(f).Decode(conf); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func ReadFlowConfig() *FlowConfig {
+	conf, err := LoadFlowConfig(flowConfigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			fmt.Println("Needs to include flow config file")
+			os.Exit(1)
+		}
+		msg := "error loading flow.json, error: " + err.Error()
+		log.Printf("[package:configuration][method:GetFlowConfig]" + msg)
+		panic(msg)
+	}
+	return conf
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,104 @@
+package configuration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validFlowJSON = `{
+	"emulators": {"default": {"port": 3569, "serviceAccount": "emulator-account"}},
+	"contracts": {
+		"PiggyBanks": "./blockchain/contracts/piggy.cdc",
+		"NonFungibleToken": {"source": "./NonFungibleToken.cdc", "aliases": {"testnet": "0x631e88ae7f1d7c20", "mainnet": "1d7e57aa55817448"}},
+		"MetadataViews": {"source": "./MetadataViews.cdc", "aliases": {"testnet": "631e88ae7f1d7c20", "mainnet": "1d7e57aa55817448"}},
+		"FungibleToken": {"aliases": {"emulator": "ee82856bf20e2aa6", "testnet": "9a0766d93b6608b7", "mainnet": "f233dcee88fe0abe"}},
+		"FlowToken": {"aliases": {"emulator": "0ae53cb6e3f42a79", "testnet": "7e60df042a9c0868", "mainnet": "1654653399040a61"}}
+	},
+	"networks": {
+		"emulator": "127.0.0.1:3569",
+		"testnet": {"host": "access.devnet.nodes.onflow.org:9000", "key": "ba69f7d2e82b9edf25b103c195cd371cf0cc047ef8884a9bbe331e62982d46daeebf836f7445a2ac16741013b192959d8ad26998aff12f2adc67a99e1eb2988d"},
+		"mainnet": "access.mainnet.nodes.onflow.org:9000"
+	},
+	"accounts": {
+		"emulator-account": {"address": "f8d6e0586b0a20c7", "key": "68ee617d9bf67a4677af80aaca5a090fcda80ff2f4dbc340e0e36201fa1f1d8c"},
+		"testnet-account": {"address": "0x36e55122ece3464c", "key": {"type": "hex", "index": 0, "signatureAlgorithm": "ECDSA_P256", "hashAlgorithm": "SHA3_256", "privateKey": "ae02806e92a9a4581ef5ea28166053afddc187329934692dd24b4a8352bb0d24"}},
+		"mainnet-account": {"address": "09e8665388e90671", "key": {"type": "google-kms", "index": 0, "signatureAlgorithm": "ECDSA_P256", "hashAlgorithm": "SHA3_256", "resourceID": "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"}}
+	},
+	"deployments": {
+		"emulator": {"emulator-account": ["NonFungibleToken", "MetadataViews", "PiggyBanks"]},
+		"testnet": {"testnet-account": [{"name": "PiggyBanks", "args": []}]},
+		"mainnet": {"mainnet-account": ["PiggyBanks"]}
+	}
+}`
+
+func parseFlowConfig(t *testing.T, data string) *FlowConfig {
+	config := &FlowConfig{}
+	require.NoError(t, json.Unmarshal([]byte(data), config))
+	return config
+}
+
+func TestFlowConfigResolvesProfiles(t *testing.T) {
+	config := parseFlowConfig(t, validFlowJSON)
+	require.NoError(t, config.Validate())
+
+	host, err := config.Host(Test)
+	require.NoError(t, err)
+	assert.Equal(t, "access.devnet.nodes.onflow.org:9000", host)
+
+	// aliases take precedence over deployments and lose the 0x prefix
+	address, err := config.ContractAddress(Test, "NonFungibleToken")
+	require.NoError(t, err)
+	assert.Equal(t, "631e88ae7f1d7c20", address)
+	address, err = config.ContractAddress(Development, "MetadataViews")
+	require.NoError(t, err)
+	assert.Equal(t, "f8d6e0586b0a20c7", address)
+	address, err = config.ContractAddress(Test, "PiggyBanks")
+	require.NoError(t, err)
+	assert.Equal(t, "36e55122ece3464c", address)
+
+	account, err := config.ServiceAccount(Test)
+	require.NoError(t, err)
+	assert.Equal(t, "0x36e55122ece3464c", account.Address)
+	assert.Equal(t, HexKey, account.Key.Type)
+	assert.Equal(t, "SHA3_256", account.Key.HashAlgorithm)
+
+	account, err = config.ServiceAccount(Production)
+	require.NoError(t, err)
+	assert.Equal(t, GoogleKMSKey, account.Key.Type)
+	assert.NotEmpty(t, account.Key.ResourceID)
+
+	account, err = config.ServiceAccount(Development)
+	require.NoError(t, err)
+	assert.Equal(t, HexKey, account.Key.Type)
+	assert.Equal(t, "68ee617d9bf67a4677af80aaca5a090fcda80ff2f4dbc340e0e36201fa1f1d8c", account.Key.PrivateKey)
+}
+
+func TestFlowConfigValidate(t *testing.T) {
+	config := parseFlowConfig(t, validFlowJSON)
+	delete(config.Networks, MainnetNetwork)
+	delete(config.Contracts["FlowToken"].Aliases, TestnetNetwork)
+	config.Accounts["testnet-account"] = FlowAccount{Address: "not an address", Key: FlowAccountKey{Type: HexKey, PrivateKey: "zz"}}
+	config.Deployments[EmulatorNetwork]["emulator-account"] = append(config.Deployments[EmulatorNetwork]["emulator-account"], FlowDeployment{Name: "Missing"})
+
+	err := config.Validate()
+	require.Error(t, err)
+	for _, problem := range []string{
+		`accounts.testnet-account: invalid address "not an address"`,
+		"accounts.testnet-account: key is not a hex private key",
+		"contracts.FungibleToken.aliases: unknown network mainnet",
+		"deployments.emulator.emulator-account: unknown contract Missing",
+		"deployments.mainnet: unknown network",
+		"contracts.FlowToken: no alias or deployment on network testnet",
+		"networks.mainnet: no host for the prod profile",
+	} {
+		assert.Contains(t, err.Error(), problem)
+	}
+}
+
+func TestRepositoryFlowConfig(t *testing.T) {
+	_, err := LoadFlowConfig("../../../flow.json")
+	assert.NoError(t, err)
+}
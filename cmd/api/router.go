@@ -1,38 +1,105 @@
 package api
 
 import (
+	"log"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	cors "github.com/itsjamie/gin-cors"
 	handler "github.com/manubidegain/piggy-api/cmd/handlers"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+	"github.com/manubidegain/piggy-api/utils"
 )
 
 func (a *App) setUserRouters() {
-	a.Router.GET("/users", a.GetAllUsers)
+	// any signed in user signs up, and is a donor until granted another role
+	a.Router.POST("/users", a.UserSignup)
+	// users read and edit their own row, admins any
 	a.Router.GET("/users/:user_id", a.GetUser)
 	a.Router.PUT("/users/:user_id", a.UpdateUser)
-	a.Router.POST("/users", a.UserSignup)
-	a.Router.DELETE("/users/:user_id", a.DeleteUser)
-	a.Router.PUT("/users/:user_id/disable", a.DisableUser)
-	a.Router.PUT("/users/:user_id/enable", a.EnableUser)
+
+	admins := a.Router.Group("/users", middlewares.Allow([]string{middlewares.AdminRole}))
+	admins.GET("", a.GetAllUsers)
+	admins.DELETE("/:user_id", a.DeleteUser)
+	admins.PUT("/:user_id/disable", a.DisableUser)
+	admins.PUT("/:user_id/enable", a.EnableUser)
+	admins.GET("/:user_id/roles", a.GetUserRoles)
+	admins.PUT("/:user_id/roles", a.SetUserRoles)
 }
 
 func (a *App) setPiggyRouters() {
 	a.Router.GET("/piggy", a.GetAllPiggies)
 	a.Router.GET("/piggy/:piggy_id", a.GetPiggy)
-	a.Router.PUT("/piggy/:piggy_id", a.UpdatePiggy)
-	a.Router.POST("/piggy", a.CreatePiggy)
-	a.Router.DELETE("/piggy/:piggy_id", a.DeletePiggy)
+
+	creators := a.Router.Group("/piggy", middlewares.Allow([]string{middlewares.CreatorRole, middlewares.AdminRole}))
+	creators.PUT("/:piggy_id", a.UpdatePiggy)
+	creators.POST("", a.CreatePiggy)
+	creators.DELETE("/:piggy_id", a.DeletePiggy)
+	creators.POST("/:piggy_id/break", a.BreakPiggy)
+}
+
+// setWebhookRouters registers the Stripe webhook. Without its secret any caller could sign events,
+// so the api refuses to start, except in dev where the webhook is left out and donations are confirmed by the client.
+func (a *App) setWebhookRouters() {
+	if a.StripeWebhookSecret == "" {
+		if a.Profile != utils.Development {
+			log.Fatalf("%s is not set, the Stripe webhook cannot verify the events", stripeWebhookSecretEnv)
+		}
+		log.Printf("%s is not set, the Stripe webhook is disabled", stripeWebhookSecretEnv)
+		return
+	}
+	a.Router.POST("/webhooks/stripe", a.StripeWebhook)
+}
+
+func (a *App) setMetadataRouters() {
+	a.Router.GET("/donation/:donation_id/metadata", a.GetDonationMetadata)
+	a.Router.GET("/media/:nft_id", a.GetDonationMedia)
 }
 
 func (a *App) setDonationRouters() {
 	a.Router.GET("/donation", a.GetAllUserDonations)
 	a.Router.GET("/donation/:donation_id", a.GetDonation)
-	a.Router.PUT("/donation/:donation_id", a.UpdateDonation)
-	a.Router.POST("/donation", a.CreateDonation)
-	a.Router.DELETE("/donation/:donation_id", a.DeleteDonation)
+
+	donors := a.Router.Group("/donation", middlewares.Allow([]string{middlewares.DonorRole, middlewares.AdminRole}))
+	donors.PUT("/:donation_id", a.UpdateDonation)
+	donors.POST("", a.CreateDonation)
+	donors.POST("/:donation_id/confirm", a.ConfirmDonation)
+	// the donation NFT is minted to the donor, who sends it on from the custodial collection
+	donors.POST("/:donation_id/transfer", a.TransferDonation)
+	donors.DELETE("/:donation_id", a.DeleteDonation)
+}
+
+func (a *App) setInvitationRouters() {
+	a.Router.GET("/invitations", a.GetUserInvitations)
+	a.Router.POST("/invitations", middlewares.Allow([]string{middlewares.CreatorRole, middlewares.AdminRole}), a.CreateInvitation)
+}
+
+func (a *App) setWalletRouters() {
+	a.Router.POST("/wallet/setup", a.SetupWallet)
+	a.Router.POST("/wallet/external/challenge", a.CreateWalletChallenge)
+	a.Router.POST("/wallet/external", a.LinkExternalWallet)
+}
+
+func (a *App) setChainRouters() {
+	a.Router.GET("/chain/piggies", a.ChainPiggies)
+	a.Router.GET("/chain/piggies/:piggy_id/metadata", a.ChainPiggyMetadata)
+	a.Router.GET("/chain/piggies/:piggy_id/donations", a.ChainPiggyDonations)
+	a.Router.GET("/chain/accounts/:address/donations", a.ChainCollection)
+	a.Router.GET("/chain/accounts/:address/donations/:nft_id", a.ChainDonation)
+	a.Router.GET("/chain/accounts/:address/donations/:nft_id/display", a.ChainDonationDisplay)
+}
+
+func (a *App) setJobRouters() {
+	a.Router.GET("/jobs/:job_id", a.GetJob)
+}
+
+func (a *App) setSupportRouters() {
+	support := a.Router.Group("/support", middlewares.Allow([]string{middlewares.AdminRole}))
+	support.GET("/donations", a.GetDonationsByStatus)
+	support.GET("/donations/status", a.GetDonationStatusCounts)
+	support.GET("/reconcile", a.ReconcileReport)
+	support.POST("/reconcile", a.ReconcileRepair)
 }
 
 // User Handlers.
@@ -41,7 +108,7 @@ func (a *App) GetAllUsers(ctx *gin.Context) {
 }
 
 func (a *App) UserSignup(ctx *gin.Context) {
-	handler.UserSignup(a.DB, ctx, a.Profile, a.FlowConfig, a.Logger, a.ProjectConfig)
+	handler.UserSignup(a.DB, ctx, a.Jobs)
 }
 
 func (a *App) GetUser(ctx *gin.Context) {
@@ -64,6 +131,14 @@ func (a *App) EnableUser(ctx *gin.Context) {
 	handler.EnableUser(a.DB, ctx)
 }
 
+func (a *App) GetUserRoles(ctx *gin.Context) {
+	handler.GetUserRoles(a.DB, ctx, a.AuthClient)
+}
+
+func (a *App) SetUserRoles(ctx *gin.Context) {
+	handler.SetUserRoles(a.DB, ctx, a.AuthClient)
+}
+
 func (a *App) ForgotPassword(ctx *gin.Context) {
 	handler.ForgotPassword(a.DB, a.AuthClient, ctx, a.Config)
 }
@@ -78,7 +153,7 @@ func (a *App) GetPiggy(ctx *gin.Context) {
 }
 
 func (a *App) CreatePiggy(ctx *gin.Context) {
-	handler.CreatePiggy(a.DB, ctx, a.FlowConfig, a.Profile, a.Logger)
+	handler.CreatePiggy(a.DB, ctx, a.Jobs)
 }
 
 func (a *App) UpdatePiggy(ctx *gin.Context) {
@@ -89,6 +164,10 @@ func (a *App) DeletePiggy(ctx *gin.Context) {
 	handler.DeletePiggy(a.DB, ctx)
 }
 
+func (a *App) BreakPiggy(ctx *gin.Context) {
+	handler.BreakPiggy(a.DB, ctx, a.FlowConfig, a.Profile, a.Logger)
+}
+
 // Donation Handlers.
 func (a *App) GetAllUserDonations(ctx *gin.Context) {
 	handler.GetAllUserDonations(a.DB, ctx)
@@ -98,8 +177,28 @@ func (a *App) GetDonation(ctx *gin.Context) {
 	handler.GetDonation(a.DB, ctx)
 }
 
+func (a *App) GetDonationMetadata(ctx *gin.Context) {
+	handler.GetDonationMetadata(a.DB, ctx)
+}
+
+func (a *App) GetDonationMedia(ctx *gin.Context) {
+	handler.GetDonationMedia(a.DB, ctx, a.Certificates)
+}
+
 func (a *App) CreateDonation(ctx *gin.Context) {
-	handler.CreateDonation(a.DB, ctx, a.FlowConfig, a.Profile, a.Logger, a.ProjectConfig)
+	handler.CreateDonation(a.DB, ctx, &a.StripeClient)
+}
+
+func (a *App) ConfirmDonation(ctx *gin.Context) {
+	handler.ConfirmDonation(a.DB, ctx, &a.StripeClient, a.Jobs)
+}
+
+func (a *App) TransferDonation(ctx *gin.Context) {
+	handler.TransferDonation(a.DB, ctx, a.FlowConfig, a.Profile, a.Jobs)
+}
+
+func (a *App) StripeWebhook(ctx *gin.Context) {
+	handler.StripeWebhook(a.DB, ctx, a.StripeWebhookSecret, a.Jobs)
 }
 
 func (a *App) UpdateDonation(ctx *gin.Context) {
@@ -110,6 +209,75 @@ func (a *App) DeleteDonation(ctx *gin.Context) {
 	handler.DeleteDonation(a.DB, ctx)
 }
 
+// Invitation Handlers.
+func (a *App) CreateInvitation(ctx *gin.Context) {
+	handler.CreateInvitation(a.DB, ctx, a.AuthClient, a.Config)
+}
+
+func (a *App) GetUserInvitations(ctx *gin.Context) {
+	handler.GetUserInvitations(a.DB, ctx)
+}
+
+// Wallet Handlers.
+func (a *App) SetupWallet(ctx *gin.Context) {
+	handler.SetupWallet(a.DB, ctx, a.Jobs)
+}
+
+func (a *App) CreateWalletChallenge(ctx *gin.Context) {
+	handler.CreateWalletChallenge(a.DB, ctx)
+}
+
+func (a *App) LinkExternalWallet(ctx *gin.Context) {
+	handler.LinkExternalWallet(a.DB, ctx, a.FlowConfig, a.Profile)
+}
+
+// Chain Handlers.
+func (a *App) ChainPiggies(ctx *gin.Context) {
+	handler.ChainPiggies(ctx, a.FlowConfig, a.Profile)
+}
+
+func (a *App) ChainPiggyMetadata(ctx *gin.Context) {
+	handler.ChainPiggyMetadata(ctx, a.FlowConfig, a.Profile)
+}
+
+func (a *App) ChainPiggyDonations(ctx *gin.Context) {
+	handler.ChainPiggyDonations(ctx, a.FlowConfig, a.Profile)
+}
+
+func (a *App) ChainCollection(ctx *gin.Context) {
+	handler.ChainCollection(ctx, a.FlowConfig, a.Profile)
+}
+
+func (a *App) ChainDonation(ctx *gin.Context) {
+	handler.ChainDonation(ctx, a.FlowConfig, a.Profile)
+}
+
+func (a *App) ChainDonationDisplay(ctx *gin.Context) {
+	handler.ChainDonationDisplay(ctx, a.FlowConfig, a.Profile)
+}
+
+// Job Handlers.
+func (a *App) GetJob(ctx *gin.Context) {
+	handler.GetJob(a.DB, ctx)
+}
+
+// Support Handlers.
+func (a *App) GetDonationsByStatus(ctx *gin.Context) {
+	handler.GetDonationsByStatus(a.DB, ctx)
+}
+
+func (a *App) GetDonationStatusCounts(ctx *gin.Context) {
+	handler.GetDonationStatusCounts(a.DB, ctx)
+}
+
+func (a *App) ReconcileReport(ctx *gin.Context) {
+	handler.Reconcile(a.DB, ctx, a.FlowConfig, a.Profile, false)
+}
+
+func (a *App) ReconcileRepair(ctx *gin.Context) {
+	handler.Reconcile(a.DB, ctx, a.FlowConfig, a.Profile, true)
+}
+
 func useCorsMiddleware(public *gin.RouterGroup) {
 	public.Use(cors.Middleware(cors.Config{
 		Origins:         "*",
@@ -0,0 +1,84 @@
+package blockchainservices
+
+import (
+	"context"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// readChain runs read on a connection to the network of the profile.
+func readChain(config *configuration.FlowConfig, profile string, read func(client access.Client, env flowUtils.Environment) error) error {
+	client, err := utils.ConnectToFlow(profile, config)
+	if err != nil {
+		return err
+	}
+	defer utils.CloseConnection(client)
+	return read(client, flowUtils.NewEnv(profile, config))
+}
+
+// GetChainPiggies returns every piggy stored in the contract.
+func GetChainPiggies(ctx context.Context, config *configuration.FlowConfig, profile string) (piggies []flowUtils.PiggyData, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		piggies, err = flowUtils.GetAllPiggies(ctx, client, env)
+		return err
+	})
+	return piggies, err
+}
+
+// GetChainPiggyMetadata returns the metadata of a piggy in the contract, false when it does not exist.
+func GetChainPiggyMetadata(ctx context.Context, piggyID uint32, config *configuration.FlowConfig, profile string) (metadata map[string]string, found bool, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		metadata, found, err = flowUtils.GetPiggyMetadata(ctx, client, env, piggyID)
+		return err
+	})
+	return metadata, found, err
+}
+
+// GetChainPiggyDonations returns the number of donations minted for a piggy.
+func GetChainPiggyDonations(ctx context.Context, piggyID uint32, config *configuration.FlowConfig, profile string) (count uint32, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		count, err = flowUtils.GetPiggyNumDonations(ctx, client, env, piggyID)
+		return err
+	})
+	return count, err
+}
+
+// GetChainCollectionIDs returns the donation IDs owned by an account, false when it has no donation collection.
+func GetChainCollectionIDs(ctx context.Context, address flow.Address, config *configuration.FlowConfig, profile string) (ids []uint64, found bool, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		ids, found, err = flowUtils.GetCollectionIDs(ctx, client, env, address)
+		return err
+	})
+	return ids, found, err
+}
+
+// GetChainDonation returns a donation owned by an account, nil when the account does not own it.
+func GetChainDonation(ctx context.Context, address flow.Address, nftID uint64, config *configuration.FlowConfig, profile string) (donation *flowUtils.DonationData, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		donation, err = flowUtils.GetDonation(ctx, client, env, address, nftID)
+		return err
+	})
+	return donation, err
+}
+
+// GetChainDonationDisplay returns the Display view of a donation owned by an account, nil when the account does not own it.
+func GetChainDonationDisplay(ctx context.Context, address flow.Address, nftID uint64, config *configuration.FlowConfig, profile string) (display *flowUtils.DisplayData, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		display, err = flowUtils.GetDonationDisplay(ctx, client, env, address, nftID)
+		return err
+	})
+	return display, err
+}
+
+// HasChainDonationCollection reports whether an account published a donation collection that can receive donations.
+func HasChainDonationCollection(ctx context.Context, address flow.Address, config *configuration.FlowConfig, profile string) (found bool, err error) {
+	err = readChain(config, profile, func(client access.Client, env flowUtils.Environment) error {
+		found, err = flowUtils.HasDonationCollection(ctx, client, env, address)
+		return err
+	})
+	return found, err
+}
@@ -1,10 +1,11 @@
 package blockchainservices
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"strings"
 
-	"github.com/gin-gonic/gin"
 	"github.com/manubidegain/piggy-api/cmd/api/configuration"
 	flowUtils "github.com/manubidegain/piggy-api/flow"
 	"github.com/manubidegain/piggy-api/utils"
@@ -14,25 +15,33 @@ import (
 // Get donations
 
 func MintDonation(userAddress string, donationComment string, piggyID uint, config *configuration.FlowConfig,
-	profile string, ctx *gin.Context, log *log.Logger, projectConfig *configuration.ProjectConfig) (uint64, error) {
+	profile string, ctx context.Context, log *log.Logger, projectConfig *configuration.ProjectConfig) (donationID uint64, err error) {
 	flowClient, err := utils.ConnectToFlow(profile, config)
 	if err != nil {
-		msg := "Cannot connect to flow" + err.Error()
-		panic(msg)
+		return 0, fmt.Errorf("cannot connect to flow: %w", err)
+	}
+	defer utils.CloseConnection(flowClient)
+	env := flowUtils.NewEnv(profile, config)
+	recipient, err := utils.GetAccount(ctx, flowClient, userAddress)
+	if err != nil {
+		return 0, utils.HandleAndLogError(log, err)
 	}
-	env := flowUtils.NewEnv(profile)
-	recipient := utils.GetAccount(ctx, flowClient, userAddress)
-	serviceAcctAddr, serviceAcctKey, signer := utils.GetServiceAccount(flowClient, config, profile)
 	recipientAddress := recipient.Address
 	//recipientSigner, _ := crypto.NewInMemorySigner(recipientPrivateKey, recipientAcctKey.HashAlgo)
 
-	mintTicketTx, err := flowUtils.MintDonation(flowClient, env, serviceAcctAddr, int(piggyID), donationComment, recipientAddress, serviceAcctKey, log)
+	serviceKey, err := utils.AcquireServiceKey(ctx, config, profile)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { serviceKey.Release(err) }()
+
+	mintTicketTx, err := flowUtils.MintDonation(flowClient, env, serviceKey.Address, int(piggyID), donationComment, recipientAddress, serviceKey.Key, log)
 	err = utils.HandleAndLogError(log, err)
 	if err != nil {
 		return 0, err
 	}
 
-	err = mintTicketTx.SignEnvelope(serviceAcctAddr, serviceAcctKey.Index, signer)
+	err = mintTicketTx.SignEnvelope(serviceKey.Address, serviceKey.Key.Index, serviceKey.Signer)
 	err = utils.HandleAndLogError(log, err)
 	if err != nil {
 		return 0, err
@@ -42,20 +51,23 @@ func MintDonation(userAddress string, donationComment string, piggyID uint, conf
 	if err != nil {
 		return 0, err
 	}
+	serviceKey.Sent()
 
-	mintTxResp := utils.WaitForSeal(ctx, flowClient, mintTicketTx.ID())
-
-	var DonationID uint64
+	mintTxResp, err := utils.WaitForSeal(ctx, flowClient, mintTicketTx.ID())
+	err = utils.HandleAndLogError(log, err)
+	if err != nil {
+		return 0, err
+	}
 
 	for _, event := range mintTxResp.Events {
 		if strings.Contains(event.Type, "Minted") {
 			value := event.Value.Fields[0].ToGoValue()
 			if uint64value, ok := value.(uint64); ok {
-				DonationID = uint64value
+				donationID = uint64value
 			}
 		}
 	}
 
-	return DonationID, nil
+	return donationID, nil
 
 }
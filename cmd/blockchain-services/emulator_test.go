@@ -0,0 +1,65 @@
+package blockchainservices
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEmbeddedEmulatorDonationFlow signs up two users, creates a piggy for the first one and mints
+// a donation to it that is then transferred, all on the in-process emulator.
+func TestEmbeddedEmulatorDonationFlow(t *testing.T) {
+	ctx := context.Background()
+	_, err := flowUtils.StartEmbeddedEmulator(ctx)
+	require.NoError(t, err)
+
+	config := &configuration.FlowConfig{}
+	projectConfig := &configuration.ProjectConfig{}
+	logger := log.New(io.Discard, "", 0)
+	profile := utils.Development
+
+	creator, err := CreateAccount(ctx, profile, config, logger, projectConfig)
+	require.NoError(t, err)
+	donor, err := CreateAccount(ctx, profile, config, logger, projectConfig)
+	require.NoError(t, err)
+	assert.NotEqual(t, creator, donor)
+
+	piggyID, err := CreateBlockchainPiggy(creator, "Trip", "Saving for the trip", config, profile, ctx, logger)
+	require.NoError(t, err)
+	metadata, found, err := GetChainPiggyMetadata(ctx, piggyID, config, profile)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "Trip", metadata["Name"])
+	assert.Equal(t, creator, metadata["Creator"])
+
+	nftID, err := MintDonation(creator, "Good luck", uint(piggyID), config, profile, ctx, logger, projectConfig)
+	require.NoError(t, err)
+	require.NotZero(t, nftID)
+	donations, err := GetChainPiggyDonations(ctx, piggyID, config, profile)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), donations)
+
+	donation, err := GetChainDonation(ctx, flow.HexToAddress(creator), nftID, config, profile)
+	require.NoError(t, err)
+	require.NotNil(t, donation)
+	assert.Equal(t, "Good luck", donation.DonationComment)
+	assert.Equal(t, piggyID, donation.PiggyID)
+
+	err = TransferUserDonation(ctx, creator, nftID, donor, config, profile, logger, projectConfig)
+	require.NoError(t, err)
+	ids, found, err := GetChainCollectionIDs(ctx, flow.HexToAddress(donor), config, profile)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []uint64{nftID}, ids)
+	ids, _, err = GetChainCollectionIDs(ctx, flow.HexToAddress(creator), config, profile)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
@@ -0,0 +1,95 @@
+package blockchainservices
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// WalletProofAppID identifies this api in the account proofs signed by external wallets.
+const WalletProofAppID = "piggy-api"
+
+var (
+	ErrInvalidWalletProof   = errors.New("signatures do not prove control of the account")
+	ErrNoDonationCollection = errors.New("account has no /public/DonationCollection capability")
+)
+
+// AccountSignature is one signature of an account proof, made with the account key KeyID.
+type AccountSignature struct {
+	KeyID     int    `json:"key_id"`
+	Signature string `json:"signature"`
+}
+
+// VerifyAccountProof checks that signatures sign the account proof of nonce with non revoked keys of account
+// adding up to the full key weight.
+func VerifyAccountProof(account *flow.Account, nonce string, signatures []AccountSignature) error {
+	message, err := flow.EncodeAccountProofMessage(account.Address, WalletProofAppID, nonce)
+	if err != nil {
+		return err
+	}
+	message = append(flow.UserDomainTag[:], message...)
+
+	weight := 0
+	signedBy := map[int]bool{}
+	for _, accountSignature := range signatures {
+		if signedBy[accountSignature.KeyID] || accountSignature.KeyID < 0 || accountSignature.KeyID >= len(account.Keys) {
+			return ErrInvalidWalletProof
+		}
+		key := account.Keys[accountSignature.KeyID]
+		if key.Revoked {
+			return ErrInvalidWalletProof
+		}
+		signature, err := hex.DecodeString(strings.TrimPrefix(accountSignature.Signature, "0x"))
+		if err != nil {
+			return ErrInvalidWalletProof
+		}
+		hasher, err := crypto.NewHasher(key.HashAlgo)
+		if err != nil {
+			return err
+		}
+		valid, err := key.PublicKey.Verify(signature, message, hasher)
+		if err != nil || !valid {
+			return ErrInvalidWalletProof
+		}
+		signedBy[accountSignature.KeyID] = true
+		weight += key.Weight
+	}
+	if weight < flow.AccountKeyWeightThreshold {
+		return ErrInvalidWalletProof
+	}
+	return nil
+}
+
+// VerifyExternalWallet checks the account proof of address against its on chain keys
+// and that the account can receive donations.
+func VerifyExternalWallet(ctx context.Context, address string, nonce string, signatures []AccountSignature, config *configuration.FlowConfig, profile string) error {
+	client, err := utils.ConnectToFlow(profile, config)
+	if err != nil {
+		return err
+	}
+	defer utils.CloseConnection(client)
+
+	account, err := client.GetAccount(ctx, flow.HexToAddress(address))
+	if err != nil {
+		return fmt.Errorf("cannot get account %s: %w", address, err)
+	}
+	if err := VerifyAccountProof(account, nonce, signatures); err != nil {
+		return err
+	}
+	hasCollection, err := flowUtils.HasDonationCollection(ctx, client, flowUtils.NewEnv(profile, config), account.Address)
+	if err != nil {
+		return err
+	}
+	if !hasCollection {
+		return ErrNoDonationCollection
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package blockchainservices
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signProof(t *testing.T, privateKey crypto.PrivateKey, address flow.Address, nonce string) string {
+	message, err := flow.EncodeAccountProofMessage(address, WalletProofAppID, nonce)
+	require.NoError(t, err)
+	signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+	require.NoError(t, err)
+	signature, err := flow.SignUserMessage(signer, message)
+	require.NoError(t, err)
+	return hex.EncodeToString(signature)
+}
+
+func TestVerifyAccountProof(t *testing.T) {
+	owner, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, make([]byte, crypto.MinSeedLength))
+	require.NoError(t, err)
+	half, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, append(make([]byte, crypto.MinSeedLength-1), 1))
+	require.NoError(t, err)
+
+	address := flow.HexToAddress("36e55122ece3464c")
+	account := &flow.Account{Address: address, Keys: []*flow.AccountKey{
+		{Index: 0, PublicKey: owner.PublicKey(), HashAlgo: crypto.SHA3_256, Weight: flow.AccountKeyWeightThreshold},
+		{Index: 1, PublicKey: half.PublicKey(), HashAlgo: crypto.SHA3_256, Weight: flow.AccountKeyWeightThreshold / 2},
+		{Index: 2, PublicKey: owner.PublicKey(), HashAlgo: crypto.SHA3_256, Weight: flow.AccountKeyWeightThreshold, Revoked: true},
+	}}
+	nonce := strings.Repeat("ab", 32)
+
+	valid := []AccountSignature{{KeyID: 0, Signature: signProof(t, owner, address, nonce)}}
+	assert.NoError(t, VerifyAccountProof(account, nonce, valid))
+
+	otherNonce := strings.Repeat("cd", 32)
+	assert.ErrorIs(t, VerifyAccountProof(account, otherNonce, valid), ErrInvalidWalletProof)
+
+	partial := []AccountSignature{{KeyID: 1, Signature: signProof(t, half, address, nonce)}}
+	assert.ErrorIs(t, VerifyAccountProof(account, nonce, partial), ErrInvalidWalletProof)
+
+	repeated := append(partial, partial[0])
+	assert.ErrorIs(t, VerifyAccountProof(account, nonce, repeated), ErrInvalidWalletProof)
+
+	revoked := []AccountSignature{{KeyID: 2, Signature: signProof(t, owner, address, nonce)}}
+	assert.ErrorIs(t, VerifyAccountProof(account, nonce, revoked), ErrInvalidWalletProof)
+
+	wrongKey := []AccountSignature{{KeyID: 0, Signature: signProof(t, half, address, nonce)}}
+	assert.ErrorIs(t, VerifyAccountProof(account, nonce, wrongKey), ErrInvalidWalletProof)
+
+	assert.Error(t, VerifyAccountProof(account, "short", valid))
+}
@@ -1,10 +1,12 @@
 package blockchainservices
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 
-	"github.com/gin-gonic/gin"
 	"github.com/manubidegain/piggy-api/cmd/api/configuration"
 	flowUtils "github.com/manubidegain/piggy-api/flow"
 	"github.com/manubidegain/piggy-api/utils"
@@ -12,28 +14,32 @@ import (
 
 // Create piggy
 
-func CreateBlockchainPiggy(userAddress string, name string, description string, config *configuration.FlowConfig, profile string, ctx *gin.Context, log *log.Logger) (uint32, error) {
+func CreateBlockchainPiggy(userAddress string, name string, description string, config *configuration.FlowConfig, profile string, ctx context.Context, log *log.Logger) (piggyID uint32, err error) {
 
 	flowClient, err := utils.ConnectToFlow(profile, config)
 	if err != nil {
-		msg := "Cannot connect to flow" + err.Error()
-		panic(msg)
+		return 0, fmt.Errorf("cannot connect to flow: %w", err)
 	}
-	env := flowUtils.NewEnv(profile)
+	defer utils.CloseConnection(flowClient)
+	env := flowUtils.NewEnv(profile, config)
 
-	serviceAcctAddr, serviceAcctKey, signer := utils.GetServiceAccount(flowClient, config, profile)
+	serviceKey, err := utils.AcquireServiceKey(ctx, config, profile)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { serviceKey.Release(err) }()
 
 	metadata := make(map[string]string)
 	metadata["Name"] = name
 	metadata["Description"] = description
 	metadata["Creator"] = userAddress
 
-	createEventTx, err := flowUtils.CreatePiggy(flowClient, env, serviceAcctAddr, metadata, serviceAcctKey, log)
+	createEventTx, err := flowUtils.CreatePiggy(flowClient, env, serviceKey.Address, metadata, serviceKey.Key, log)
 	if err != nil {
 		return 0, err
 	}
 
-	err = createEventTx.SignEnvelope(serviceAcctAddr, serviceAcctKey.Index, signer)
+	err = createEventTx.SignEnvelope(serviceKey.Address, serviceKey.Key.Index, serviceKey.Signer)
 	if err != nil {
 		return 0, err
 	}
@@ -42,14 +48,13 @@ func CreateBlockchainPiggy(userAddress string, name string, description string,
 	if err != nil {
 		return 0, err
 	}
+	serviceKey.Sent()
 
-	mintTxResp := utils.WaitForSeal(ctx, flowClient, createEventTx.ID())
-	if mintTxResp.Error != nil {
-		return 0, mintTxResp.Error
+	mintTxResp, err := utils.WaitForSeal(ctx, flowClient, createEventTx.ID())
+	if err != nil {
+		return 0, err
 	}
 
-	var piggyID uint32
-
 	for _, event := range mintTxResp.Events {
 		if strings.Contains(event.Type, "Piggy") {
 			value := event.Value.Fields[0].ToGoValue()
@@ -61,3 +66,50 @@ func CreateBlockchainPiggy(userAddress string, name string, description string,
 	return piggyID, nil
 
 }
+
+// Break piggy
+
+func BreakBlockchainPiggy(piggyID uint, collectedAmount uint64, breakerRoyalty uint64, config *configuration.FlowConfig, profile string, ctx context.Context, log *log.Logger) (err error) {
+
+	flowClient, err := utils.ConnectToFlow(profile, config)
+	if err != nil {
+		return fmt.Errorf("cannot connect to flow: %w", err)
+	}
+	defer utils.CloseConnection(flowClient)
+	env := flowUtils.NewEnv(profile, config)
+
+	serviceKey, err := utils.AcquireServiceKey(ctx, config, profile)
+	if err != nil {
+		return err
+	}
+	defer func() { serviceKey.Release(err) }()
+
+	breakTx, err := flowUtils.BreakPiggy(flowClient, env, serviceKey.Address, int(piggyID), collectedAmount, breakerRoyalty, serviceKey.Key, log)
+	if err != nil {
+		return err
+	}
+
+	err = breakTx.SignEnvelope(serviceKey.Address, serviceKey.Key.Index, serviceKey.Signer)
+	if err != nil {
+		return err
+	}
+
+	err = flowClient.SendTransaction(ctx, *breakTx)
+	if err != nil {
+		return err
+	}
+	serviceKey.Sent()
+
+	breakTxResp, err := utils.WaitForSeal(ctx, flowClient, breakTx.ID())
+	if err != nil {
+		return err
+	}
+
+	for _, event := range breakTxResp.Events {
+		if strings.Contains(event.Type, "SetBroken") {
+			return nil
+		}
+	}
+	return errors.New("piggy was already broken on chain")
+
+}
@@ -0,0 +1,56 @@
+package blockchainservices
+
+import (
+	"context"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+)
+
+// ChainPiggy is a piggy as stored in the contract.
+type ChainPiggy struct {
+	flowUtils.PiggyData
+	Donations uint32 `json:"donations"`
+}
+
+// ChainState is the contract state the database is reconciled against.
+type ChainState struct {
+	Piggies     []ChainPiggy `json:"piggies"`
+	TotalSupply uint64       `json:"total_supply"`
+}
+
+// ReadChainState reads every piggy with its metadata and donation count, and the NFT total supply.
+func ReadChainState(ctx context.Context, config *configuration.FlowConfig, profile string) (*ChainState, error) {
+	client, err := utils.ConnectToFlow(profile, config)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.CloseConnection(client)
+	env := flowUtils.NewEnv(profile, config)
+
+	piggies, err := flowUtils.GetAllPiggies(ctx, client, env)
+	if err != nil {
+		return nil, err
+	}
+	state := &ChainState{}
+	for _, piggy := range piggies {
+		metadata, found, err := flowUtils.GetPiggyMetadata(ctx, client, env, piggy.ID)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			piggy.Metadata = metadata
+		}
+		donations, err := flowUtils.GetPiggyNumDonations(ctx, client, env, piggy.ID)
+		if err != nil {
+			return nil, err
+		}
+		state.Piggies = append(state.Piggies, ChainPiggy{PiggyData: piggy, Donations: donations})
+	}
+	state.TotalSupply, err = flowUtils.GetTotalSupply(ctx, client, env)
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
@@ -1,10 +1,11 @@
 package blockchainservices
 
-// Create account and store account on datastore
+// Create account and store account key on the account key store
 
 // GetKey to sign
 
 import (
+	"context"
 	"crypto/rand"
 	"log"
 	"net/http"
@@ -14,35 +15,36 @@ import (
 	flowUtils "github.com/manubidegain/piggy-api/flow"
 	"github.com/manubidegain/piggy-api/utils"
 	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
 	"github.com/onflow/flow-go-sdk/crypto"
-	"github.com/onflow/flow-go-sdk/examples"
 	"github.com/onflow/flow-go-sdk/templates"
 )
 
 func GetAccount(ctx *gin.Context, profile string, config *configuration.FlowConfig) {
 	client, err := utils.ConnectToFlow(profile, config)
 	if err != nil {
-		msg := "Cannot connect to flow" + err.Error()
-		panic(msg)
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
 	}
+	defer utils.CloseConnection(client)
 	address := ctx.Param("address")
-	account := utils.GetAccount(ctx, client, address)
-	if account == nil {
+	account, err := utils.GetAccount(ctx, client, address)
+	if err != nil {
 		ctx.IndentedJSON(http.StatusNotFound, "Account not found")
 		return
 	}
-	utils.CloseConnection(client)
 	ctx.IndentedJSON(http.StatusOK, account)
 }
 
 func TemporaryGetValue(ctx *gin.Context, profile string, projectConfig *configuration.ProjectConfig) {
 	address := ctx.Param("address")
-	dataStoreClient, err := utils.SetupDataStoreClient("dev", projectConfig)
+	keys, err := utils.OpenAccountKeyStore(profile, projectConfig)
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, nil)
 		return
 	}
-	readed, err := utils.GetValue(ctx, dataStoreClient, address, "Account", profile, projectConfig)
+	defer keys.Close()
+	readed, err := keys.Load(ctx, address)
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, nil)
 		return
@@ -50,16 +52,14 @@ func TemporaryGetValue(ctx *gin.Context, profile string, projectConfig *configur
 	ctx.IndentedJSON(http.StatusOK, readed)
 }
 
-func CreateAccount(ctx *gin.Context, profile string, config *configuration.FlowConfig, log *log.Logger, projectConfig *configuration.ProjectConfig) (string, error) {
+func CreateAccount(ctx context.Context, profile string, config *configuration.FlowConfig, log *log.Logger, projectConfig *configuration.ProjectConfig) (string, error) {
 	client, err := utils.ConnectToFlow(profile, config)
-	env := flowUtils.NewEnv(profile)
 	if err != nil {
 		log.Println("Cannot connect to flow with profile " + profile)
-		msg := "Cannot connect to flow" + err.Error()
-		panic(msg)
+		return "", err
 	}
-
-	serviceAcctAddr, serviceAcctKey, serviceSigner := utils.GetServiceAccount(client, config, profile)
+	defer utils.CloseConnection(client)
+	env := flowUtils.NewEnv(profile, config)
 
 	//Handle more options
 	myPrivateKey := createRandomPrivateKey()
@@ -75,116 +75,129 @@ func CreateAccount(ctx *gin.Context, profile string, config *configuration.FlowC
 		return "", err
 	}
 
-	referenceBlockID := utils.GetReferenceBlockId(client, log)
-	createAccountTx, err := templates.CreateAccount([]*flow.AccountKey{newAcctKey}, nil, serviceAcctAddr)
-	createAccountTx.SetProposalKey(
-		serviceAcctAddr,
-		serviceAcctKey.Index,
-		serviceAcctKey.SequenceNumber,
-	)
-	createAccountTx.SetReferenceBlockID(referenceBlockID)
-	createAccountTx.SetPayer(serviceAcctAddr)
-
+	serviceKey, err := utils.AcquireServiceKey(ctx, config, profile)
 	if err != nil {
-		msg := "cannot generate the transaction: " + err.Error()
-		log.Println(msg)
-		panic(msg)
 		return "", err
 	}
-
-	err = createAccountTx.SignEnvelope(serviceAcctAddr, serviceAcctKey.Index, serviceSigner)
+	newAddress, err := createFundedAccount(ctx, client, env, newAcctKey, serviceKey, log)
+	serviceKey.Release(err)
 	if err != nil {
-		msg := "cannot sign envelope : " + err.Error()
-		log.Println(msg)
-		panic(msg)
 		return "", err
 	}
 
-	// Send the transaction to the network
-	err = client.SendTransaction(ctx, *createAccountTx)
+	// Setup acc
+	tx2, err := flowUtils.SetupAccount(client, env, newAddress, newAcctKey, log)
 	if err != nil {
-		msg := "error sending transaction" + err.Error()
-		log.Println(msg)
-		panic(msg)
 		return "", err
 	}
 
-	accountCreationTxRes := examples.WaitForSeal(ctx, client, createAccountTx.ID())
-
-	var newAddress flow.Address
-
-	for _, event := range accountCreationTxRes.Events {
-		if event.Type == flow.EventAccountCreated {
-			accountCreatedEvent := flow.AccountCreatedEvent(event)
-			newAddress = accountCreatedEvent.Address()
-		}
-	}
-
-	newServiceAccountKey := serviceAcctKey
-	newServiceAccountKey.SequenceNumber++
-
-	tx := flowUtils.FundAccount(client, env, newAddress, 0.001, serviceAcctAddr, newServiceAccountKey, log)
-
-	err = tx.SignEnvelope(serviceAcctAddr, serviceAcctKey.Index, serviceSigner)
+	err = tx2.SignEnvelope(newAddress, newAcctKey.Index, anotherSigner)
 	err = utils.HandleAndLogError(log, err)
 	if err != nil {
 		return "", err
 	}
-	err = client.SendTransaction(ctx, *tx)
+	err = client.SendTransaction(ctx, *tx2)
 	err = utils.HandleAndLogError(log, err)
 	if err != nil {
 		return "", err
 	}
 
-	createMinterTxResp := utils.WaitForSeal(ctx, client, tx.ID())
-	err = utils.HandleAndLogError(log, createMinterTxResp.Error)
+	_, err = utils.WaitForSeal(ctx, client, tx2.ID())
+	err = utils.HandleAndLogError(log, err)
 	if err != nil {
 		return "", err
 	}
 
-	// Setup acc
-	tx2 := flowUtils.SetupAccount(client, env, newAddress, newAcctKey, log)
-
-	err = tx2.SignEnvelope(newAddress, newAcctKey.Index, anotherSigner)
-	err = utils.HandleAndLogError(log, err)
+	keys, err := utils.OpenAccountKeyStore(profile, projectConfig)
 	if err != nil {
+		log.Println(err)
 		return "", err
 	}
-	err = client.SendTransaction(ctx, *tx2)
-	err = utils.HandleAndLogError(log, err)
+	defer keys.Close()
+	err = keys.Save(ctx, newAddress.Hex(), newAcctKey.PublicKey.String(), myPrivateKey.String())
 	if err != nil {
+		log.Println(err)
 		return "", err
 	}
 
-	createMinterTxResp = utils.WaitForSeal(ctx, client, tx2.ID())
-	err = utils.HandleAndLogError(log, createMinterTxResp.Error)
+	return newAddress.Hex(), nil
+}
+
+// createFundedAccount creates an account with accountKey and funds it, proposing both transactions with serviceKey.
+func createFundedAccount(ctx context.Context, client access.Client, env flowUtils.Environment, accountKey *flow.AccountKey, serviceKey *utils.ServiceKey, log *log.Logger) (flow.Address, error) {
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
 	if err != nil {
-		return "", err
+		return flow.EmptyAddress, err
+	}
+	createAccountTx, err := templates.CreateAccount([]*flow.AccountKey{accountKey}, nil, serviceKey.Address)
+	if err != nil {
+		msg := "cannot generate the transaction: " + err.Error()
+		log.Println(msg)
+		return flow.EmptyAddress, err
 	}
+	createAccountTx.SetProposalKey(
+		serviceKey.Address,
+		serviceKey.Key.Index,
+		serviceKey.Key.SequenceNumber,
+	)
+	createAccountTx.SetReferenceBlockID(referenceBlockID)
+	createAccountTx.SetPayer(serviceKey.Address)
 
-	// Fund acc
+	err = createAccountTx.SignEnvelope(serviceKey.Address, serviceKey.Key.Index, serviceKey.Signer)
+	if err != nil {
+		msg := "cannot sign envelope : " + err.Error()
+		log.Println(msg)
+		return flow.EmptyAddress, err
+	}
 
-	utils.CloseConnection(client)
+	// Send the transaction to the network
+	err = client.SendTransaction(ctx, *createAccountTx)
+	if err != nil {
+		msg := "error sending transaction" + err.Error()
+		log.Println(msg)
+		return flow.EmptyAddress, err
+	}
+	serviceKey.Sent()
 
-	dataStoreClient, err := utils.SetupDataStoreClient("dev", projectConfig)
+	accountCreationTxRes, err := utils.WaitForSeal(ctx, client, createAccountTx.ID())
+	err = utils.HandleAndLogError(log, err)
 	if err != nil {
-		log.Println(err)
-		return "", err
+		return flow.EmptyAddress, err
 	}
-	entry, err := utils.CreateNewEntry(newAddress.Hex(), newAcctKey.PublicKey.String(), myPrivateKey.String(), projectConfig)
+
+	var newAddress flow.Address
+
+	for _, event := range accountCreationTxRes.Events {
+		if event.Type == flow.EventAccountCreated {
+			accountCreatedEvent := flow.AccountCreatedEvent(event)
+			newAddress = accountCreatedEvent.Address()
+		}
+	}
+
+	// Fund acc
+	tx, err := flowUtils.FundAccount(client, env, newAddress, 0.001, serviceKey.Address, serviceKey.Key, log)
 	if err != nil {
-		log.Println(err)
-		return "", err
+		return flow.EmptyAddress, err
 	}
 
-	key, err := utils.UploadValue(ctx, entry, "Account", dataStoreClient)
+	err = tx.SignEnvelope(serviceKey.Address, serviceKey.Key.Index, serviceKey.Signer)
+	err = utils.HandleAndLogError(log, err)
 	if err != nil {
-		log.Println(err)
-		return "", err
+		return flow.EmptyAddress, err
 	}
-	dataStoreClient.Close()
+	err = client.SendTransaction(ctx, *tx)
+	err = utils.HandleAndLogError(log, err)
+	if err != nil {
+		return flow.EmptyAddress, err
+	}
+	serviceKey.Sent()
 
-	return key, nil
+	_, err = utils.WaitForSeal(ctx, client, tx.ID())
+	err = utils.HandleAndLogError(log, err)
+	if err != nil {
+		return flow.EmptyAddress, err
+	}
+	return newAddress, nil
 }
 
 func createRandomPrivateKey() crypto.PrivateKey {
@@ -0,0 +1,111 @@
+package blockchainservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// CustodialWallet signs transactions as a user account whose key is kept in the account key store.
+type CustodialWallet struct {
+	Address flow.Address
+	Key     *flow.AccountKey
+	Signer  utils.Signer
+}
+
+// OpenCustodialWallet decrypts the stored key of the account at address and finds the matching key on chain.
+func OpenCustodialWallet(ctx context.Context, client access.Client, address string, profile string, projectConfig *configuration.ProjectConfig) (*CustodialWallet, error) {
+	accountAddress := flow.HexToAddress(address)
+	keys, err := utils.OpenAccountKeyStore(profile, projectConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer keys.Close()
+
+	readed, err := keys.Load(ctx, accountAddress.Hex())
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, strings.TrimPrefix(readed.PrivateKey, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.GetAccount(ctx, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range account.Keys {
+		if key.Revoked || !key.PublicKey.Equals(privateKey.PublicKey()) {
+			continue
+		}
+		signer, err := utils.NewInMemorySigner(privateKey, key.HashAlgo)
+		if err != nil {
+			return nil, err
+		}
+		return &CustodialWallet{Address: accountAddress, Key: key, Signer: signer}, nil
+	}
+	return nil, fmt.Errorf("account %s has no key matching its custodial key", accountAddress)
+}
+
+// send signs tx as the wallet account, which proposes, pays and authorizes it, and waits for it to be sealed.
+func (w *CustodialWallet) send(ctx context.Context, client access.Client, tx *flow.Transaction) (*flow.TransactionResult, error) {
+	if err := tx.SignEnvelope(w.Address, w.Key.Index, w.Signer); err != nil {
+		return nil, err
+	}
+	if err := client.SendTransaction(ctx, *tx); err != nil {
+		return nil, err
+	}
+	w.Key.SequenceNumber++
+	return utils.WaitForSeal(ctx, client, tx.ID())
+}
+
+// SetupUserAccount runs setup_account.cdc again for a custodial account, creating its donation collection if missing.
+func SetupUserAccount(ctx context.Context, userAddress string, config *configuration.FlowConfig, profile string, log *log.Logger, projectConfig *configuration.ProjectConfig) error {
+	client, err := utils.ConnectToFlow(profile, config)
+	if err != nil {
+		return err
+	}
+	defer utils.CloseConnection(client)
+	env := flowUtils.NewEnv(profile, config)
+
+	wallet, err := OpenCustodialWallet(ctx, client, userAddress, profile, projectConfig)
+	if err != nil {
+		return utils.HandleAndLogError(log, err)
+	}
+	tx, err := flowUtils.SetupAccount(client, env, wallet.Address, wallet.Key, log)
+	if err != nil {
+		return utils.HandleAndLogError(log, err)
+	}
+	_, err = wallet.send(ctx, client, tx)
+	return utils.HandleAndLogError(log, err)
+}
+
+// TransferUserDonation moves a donation NFT from a custodial account to the collection of recipient.
+func TransferUserDonation(ctx context.Context, userAddress string, nftID uint64, recipient string, config *configuration.FlowConfig, profile string, log *log.Logger, projectConfig *configuration.ProjectConfig) error {
+	client, err := utils.ConnectToFlow(profile, config)
+	if err != nil {
+		return err
+	}
+	defer utils.CloseConnection(client)
+	env := flowUtils.NewEnv(profile, config)
+
+	wallet, err := OpenCustodialWallet(ctx, client, userAddress, profile, projectConfig)
+	if err != nil {
+		return utils.HandleAndLogError(log, err)
+	}
+	tx, err := flowUtils.TransferDonation(client, env, wallet.Address, nftID, flow.HexToAddress(recipient), wallet.Key, log)
+	if err != nil {
+		return utils.HandleAndLogError(log, err)
+	}
+	_, err = wallet.send(ctx, client, tx)
+	return utils.HandleAndLogError(log, err)
+}
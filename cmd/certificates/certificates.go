@@ -0,0 +1,316 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	_ "golang.org/x/image/webp"
+)
+
+// Widths of the renditions the PiggyBanks contract links, thumbnail and mediumimage.
+const (
+	ThumbnailWidth = 256
+	MediumWidth    = 512
+)
+
+const (
+	fetchTimeout   = 5 * time.Second
+	maxImageBytes  = 5 << 20
+	maxImagePixels = 4096 * 4096
+	commentLines   = 3
+)
+
+var (
+	background = color.RGBA{0xfd, 0xe4, 0xec, 0xff}
+	panel      = color.RGBA{0xf8, 0xbb, 0xd0, 0xff}
+	ink        = color.RGBA{0x3a, 0x1f, 0x2b, 0xff}
+	muted      = color.RGBA{0x7a, 0x4b, 0x5e, 0xff}
+
+	regular = mustParseFont(goregular.TTF)
+	bold    = mustParseFont(gobold.TTF)
+)
+
+// Card is the data printed on a donation certificate.
+type Card struct {
+	NftID        uint64
+	PiggyName    string
+	PiggyImage   string
+	SerialNumber uint32
+	Comment      string
+}
+
+// Renderer draws donation certificates and keeps the renditions in a cache directory.
+type Renderer struct {
+	dir    string
+	client *http.Client
+}
+
+// New builds a renderer caching the images in dir.
+// The piggy images are only fetched from public addresses, the redirects included,
+// so a piggy image cannot make the api call its internal network.
+func New(dir string) *Renderer {
+	dialer := &net.Dialer{Timeout: fetchTimeout, Control: dialPublic}
+	transport := &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: fetchTimeout}
+	return &Renderer{dir: dir, client: &http.Client{Timeout: fetchTimeout, Transport: transport}}
+}
+
+var (
+	errNonPublicAddress = errors.New("image host is not a public address")
+	errImageTooLarge    = errors.New("image is too large")
+)
+
+// reservedNets are the ranges out of the internet the net package does not classify.
+var reservedNets = mustParseCIDRs("0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15", "240.0.0.0/4", "64:ff9b::/96")
+
+// dialPublic refuses to connect to addresses that are not public. It runs on the resolved address,
+// so a public name resolving to the internal network is refused as well.
+func dialPublic(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+		return fmt.Errorf("%w: %s", errNonPublicAddress, host)
+	}
+	return nil
+}
+
+// publicIP reports whether ip is routable on the internet, not loopback, private, link-local or reserved.
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, reserved := range reservedNets {
+		if reserved.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidWidth reports whether width is one of the renditions the contract links.
+func ValidWidth(width int) bool {
+	return width == ThumbnailWidth || width == MediumWidth
+}
+
+// Render returns the path of the JPEG rendition of card at width, drawing it when it is not cached.
+// The cached file is named after the card content, so editing the piggy renders it again.
+// When the piggy image cannot be fetched the card is drawn without it, out of the cache, and complete is false:
+// the next request tries the image again.
+func (r *Renderer) Render(ctx context.Context, card Card, width int) (path string, complete bool, err error) {
+	if !ValidWidth(width) {
+		return "", false, fmt.Errorf("unsupported certificate width %d", width)
+	}
+	path = filepath.Join(r.dir, fmt.Sprintf("%d-%d-%s.jpg", card.NftID, width, card.hash()))
+	if _, err := os.Stat(path); err == nil {
+		return path, true, nil
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return "", false, err
+	}
+
+	certificate, complete := r.draw(ctx, card, width)
+	if !complete {
+		path = filepath.Join(r.dir, fmt.Sprintf("%d-%d-fallback.jpg", card.NftID, width))
+	}
+	// write aside and rename, so concurrent requests never serve a partial file
+	file, err := os.CreateTemp(r.dir, "render-*.jpg")
+	if err != nil {
+		return "", false, err
+	}
+	defer os.Remove(file.Name())
+	if err := jpeg.Encode(file, certificate, &jpeg.Options{Quality: 90}); err != nil {
+		file.Close()
+		return "", false, err
+	}
+	if err := file.Close(); err != nil {
+		return "", false, err
+	}
+	return path, complete, os.Rename(file.Name(), path)
+}
+
+func (c Card) hash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%d\x00%s", c.PiggyName, c.PiggyImage, c.SerialNumber, c.Comment)))
+	return hex.EncodeToString(sum[:6])
+}
+
+// draw paints the certificate of card, fetched is false when the piggy image could not be fetched.
+func (r *Renderer) draw(ctx context.Context, card Card, width int) (certificate *image.RGBA, fetched bool) {
+	scale := float64(width) / MediumWidth
+	margin := int(24 * scale)
+	canvas := image.NewRGBA(image.Rect(0, 0, width, width))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	picture := image.Rect(margin, margin, width-margin, width*11/20)
+	draw.Draw(canvas, picture, image.NewUniform(panel), image.Point{}, draw.Src)
+	piggyImage, err := r.fetch(ctx, card.PiggyImage)
+	if piggyImage != nil {
+		drawCover(canvas, picture, piggyImage)
+	}
+
+	textWidth := width - 2*margin
+	y := picture.Max.Y + int(44*scale)
+	title := newFace(bold, 30*scale)
+	drawText(canvas, title, ink, margin, y, ellipsis(title, card.PiggyName, textWidth))
+	y += int(36 * scale)
+	subtitle := newFace(regular, 22*scale)
+	drawText(canvas, subtitle, muted, margin, y, fmt.Sprintf("Donation #%d", card.SerialNumber))
+	y += int(34 * scale)
+	body := newFace(regular, 18*scale)
+	for _, line := range wrap(body, card.Comment, textWidth, commentLines) {
+		drawText(canvas, body, ink, margin, y, line)
+		y += int(24 * scale)
+	}
+	return canvas, err == nil
+}
+
+// fetch downloads the piggy image, nil without error when the piggy has no image url.
+// The dimensions are checked before decoding, a small file can declare an image too large to hold in memory.
+func (r *Renderer) fetch(ctx context.Context, url string) (image.Image, error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, nil
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image responded %s", response.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxImageBytes {
+		return nil, errImageTooLarge
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width > maxImagePixels/config.Height {
+		return nil, fmt.Errorf("%w: %dx%d", errImageTooLarge, config.Width, config.Height)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(body))
+	return decoded, err
+}
+
+// drawCover scales src to fill rect, cropping the overflow around the center.
+func drawCover(dst draw.Image, rect image.Rectangle, src image.Image) {
+	bounds := src.Bounds()
+	if bounds.Empty() {
+		return
+	}
+	crop := bounds
+	if bounds.Dx()*rect.Dy() > bounds.Dy()*rect.Dx() {
+		width := bounds.Dy() * rect.Dx() / rect.Dy()
+		crop.Min.X += (bounds.Dx() - width) / 2
+		crop.Max.X = crop.Min.X + width
+	} else {
+		height := bounds.Dx() * rect.Dy() / rect.Dx()
+		crop.Min.Y += (bounds.Dy() - height) / 2
+		crop.Max.Y = crop.Min.Y + height
+	}
+	draw.CatmullRom.Scale(dst, rect, src, crop, draw.Over, nil)
+}
+
+func drawText(dst draw.Image, face font.Face, ink color.Color, x int, y int, text string) {
+	drawer := font.Drawer{Dst: dst, Src: image.NewUniform(ink), Face: face, Dot: fixed.P(x, y)}
+	drawer.DrawString(text)
+}
+
+// wrap splits text in lines no wider than width, shortening the last one when it does not fit in max lines.
+func wrap(face font.Face, text string, width int, max int) []string {
+	lines := []string{}
+	line := ""
+	words := strings.Fields(text)
+	for i, word := range words {
+		candidate := strings.TrimSpace(line + " " + word)
+		if line == "" || font.MeasureString(face, candidate).Ceil() <= width {
+			line = candidate
+			continue
+		}
+		if len(lines) == max-1 {
+			return append(lines, ellipsis(face, strings.Join(append([]string{line}, words[i:]...), " ")+"…", width))
+		}
+		lines = append(lines, ellipsis(face, line, width))
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, ellipsis(face, line, width))
+	}
+	return lines
+}
+
+// ellipsis shortens text to fit in width.
+func ellipsis(face font.Face, text string, width int) string {
+	if font.MeasureString(face, text).Ceil() <= width {
+		return text
+	}
+	runes := []rune(strings.TrimSuffix(text, "…"))
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		shortened := strings.TrimSpace(string(runes)) + "…"
+		if font.MeasureString(face, shortened).Ceil() <= width {
+			return shortened
+		}
+	}
+	return ""
+}
+
+func newFace(parsed *opentype.Font, size float64) font.Face {
+	face, err := opentype.NewFace(parsed, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		panic(err)
+	}
+	return face
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, parsed, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = parsed
+	}
+	return nets
+}
+
+func mustParseFont(ttf []byte) *opentype.Font {
+	parsed, err := opentype.Parse(ttf)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
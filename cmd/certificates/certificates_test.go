@@ -0,0 +1,137 @@
+package certificates
+
+import (
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font"
+)
+
+func TestRenderCachesRenditions(t *testing.T) {
+	renderer := New(t.TempDir())
+	card := Card{NftID: 12, PiggyName: "Trip to the mountains", SerialNumber: 3, Comment: "Have a great trip"}
+
+	path, complete, err := renderer.Render(context.Background(), card, ThumbnailWidth)
+	require.NoError(t, err)
+	assert.True(t, complete)
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	config, err := jpeg.DecodeConfig(file)
+	require.NoError(t, err)
+	assert.Equal(t, ThumbnailWidth, config.Width)
+
+	cached, _, err := renderer.Render(context.Background(), card, ThumbnailWidth)
+	require.NoError(t, err)
+	assert.Equal(t, path, cached)
+
+	medium, _, err := renderer.Render(context.Background(), card, MediumWidth)
+	require.NoError(t, err)
+	assert.NotEqual(t, path, medium)
+
+	card.PiggyName = "Trip to the beach"
+	renamed, _, err := renderer.Render(context.Background(), card, ThumbnailWidth)
+	require.NoError(t, err)
+	assert.NotEqual(t, path, renamed)
+
+	_, _, err = renderer.Render(context.Background(), card, 300)
+	assert.Error(t, err)
+}
+
+func TestRenderSkipsTheCacheWithoutTheImage(t *testing.T) {
+	available := atomic.Bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		png.Encode(w, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+	}))
+	defer server.Close()
+	renderer := &Renderer{dir: t.TempDir(), client: server.Client()}
+	card := Card{NftID: 12, PiggyName: "Trip", PiggyImage: server.URL + "/image.png", SerialNumber: 3}
+
+	fallback, complete, err := renderer.Render(context.Background(), card, ThumbnailWidth)
+	require.NoError(t, err)
+	assert.False(t, complete)
+	assert.FileExists(t, fallback)
+
+	available.Store(true)
+	path, complete, err := renderer.Render(context.Background(), card, ThumbnailWidth)
+	require.NoError(t, err)
+	assert.True(t, complete)
+	assert.NotEqual(t, fallback, path)
+	cached, _, err := renderer.Render(context.Background(), card, ThumbnailWidth)
+	require.NoError(t, err)
+	assert.Equal(t, path, cached)
+}
+
+func TestFetchRefusesLargeImages(t *testing.T) {
+	// a few bytes of GIF declaring a 65535x65535 image
+	huge := []byte("GIF89a\xff\xff\xff\xff\x00\x00\x00")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/huge.gif" {
+			w.Write(huge)
+			return
+		}
+		w.Write(make([]byte, maxImageBytes+1))
+	}))
+	defer server.Close()
+	renderer := &Renderer{client: server.Client()}
+
+	_, err := renderer.fetch(context.Background(), server.URL+"/huge.gif")
+	assert.ErrorIs(t, err, errImageTooLarge)
+	_, err = renderer.fetch(context.Background(), server.URL+"/heavy.png")
+	assert.ErrorIs(t, err, errImageTooLarge)
+	fetched, err := renderer.fetch(context.Background(), "piggy.png")
+	assert.NoError(t, err)
+	assert.Nil(t, fetched)
+}
+
+func TestWrap(t *testing.T) {
+	face := newFace(regular, 18)
+	width := font.MeasureString(face, "aaaa bbbb").Ceil()
+
+	assert.Equal(t, []string{"aaaa bbbb", "cccc"}, wrap(face, "aaaa bbbb cccc", width, 3))
+	lines := wrap(face, "aaaa bbbb cccc dddd eeee ffff gggg", width, 2)
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "aaaa bbbb", lines[0])
+	assert.True(t, font.MeasureString(face, lines[1]).Ceil() <= width)
+	assert.Contains(t, lines[1], "…")
+	assert.Empty(t, wrap(face, "", width, 3))
+}
+
+func TestFetchRefusesNonPublicHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		png.Encode(w, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+	}))
+	defer server.Close()
+
+	// the image is served, but from loopback
+	permissive := &Renderer{client: server.Client()}
+	fetched, err := permissive.fetch(context.Background(), server.URL+"/image.png")
+	require.NoError(t, err)
+	assert.NotNil(t, fetched)
+	renderer := New(t.TempDir())
+	_, err = renderer.fetch(context.Background(), server.URL+"/image.png")
+	assert.ErrorIs(t, err, errNonPublicAddress)
+
+	for _, address := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "0.0.0.0", "100.64.0.1", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1"} {
+		assert.False(t, publicIP(net.ParseIP(address)), address)
+	}
+	for _, address := range []string{"8.8.8.8", "151.101.1.69", "2606:4700::1111"} {
+		assert.True(t, publicIP(net.ParseIP(address)), address)
+	}
+	assert.ErrorIs(t, dialPublic("tcp", "169.254.169.254:80", nil), errNonPublicAddress)
+	assert.NoError(t, dialPublic("tcp", "8.8.8.8:443", nil))
+}
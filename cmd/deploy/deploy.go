@@ -0,0 +1,219 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const contractName = "PiggyBanks"
+
+// Action is what deploying the local contract does to the account.
+type Action string
+
+const (
+	ActionDeploy   Action = "deploy"
+	ActionUpdate   Action = "update"
+	ActionUpToDate Action = "up to date"
+)
+
+// ErrIncompatibleUpdate is returned when the local contract cannot replace the deployed one.
+var ErrIncompatibleUpdate = errors.New("incompatible contract update")
+
+// Run deploys or updates PiggyBanks on the account of the profile network, printing the diff and the transaction id.
+// Usage: deploy [-profile dev|test|prod] [-dry-run]
+func Run(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("deploy", flag.ContinueOnError)
+	flags.SetOutput(out)
+	profile := flags.String("profile", defaultProfile(), "profile of the network to deploy to: dev (emulator), test or prod")
+	dryRun := flags.Bool("dry-run", false, "print the changes without sending the transaction")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	config := configuration.ReadFlowConfig()
+	env := flowUtils.NewEnv(*profile, config)
+	address := flow.HexToAddress(env.PiggyAddress)
+	local := string(flowUtils.PiggyCode(env))
+
+	client, err := utils.ConnectToFlow(*profile, config)
+	if err != nil {
+		return err
+	}
+	defer utils.CloseConnection(client)
+	account, err := client.GetAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	deployed := string(account.Contracts[contractName])
+	action, err := Plan(address, deployed, local)
+	if deployed != "" && deployed != local {
+		fmt.Fprintln(out, Diff(deployed, local))
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s at %s: %s\n", contractName, address.Hex(), action)
+	if action == ActionUpToDate || *dryRun {
+		return nil
+	}
+
+	serviceKey, err := utils.AcquireServiceKey(ctx, config, *profile)
+	if err != nil {
+		return err
+	}
+	defer func() { serviceKey.Release(err) }()
+	if serviceKey.Address != address {
+		return fmt.Errorf("service account %s does not hold %s at %s", serviceKey.Address.Hex(), contractName, address.Hex())
+	}
+
+	contract := templates.Contract{Name: contractName, Source: local}
+	tx := templates.AddAccountContract(address, contract)
+	if action == ActionUpdate {
+		tx = templates.UpdateAccountContract(address, contract)
+	}
+	block, err := client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return err
+	}
+	tx.SetReferenceBlockID(block.ID).
+		SetGasLimit(9999).
+		SetProposalKey(address, serviceKey.Key.Index, serviceKey.Key.SequenceNumber).
+		SetPayer(address)
+	if err = tx.SignEnvelope(address, serviceKey.Key.Index, serviceKey.Signer); err != nil {
+		return err
+	}
+	if err = client.SendTransaction(ctx, *tx); err != nil {
+		return err
+	}
+	serviceKey.Sent()
+	fmt.Fprintf(out, "transaction %s\n", tx.ID())
+
+	_, err = utils.WaitForSeal(ctx, client, tx.ID())
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "sealed")
+	return nil
+}
+
+// Plan returns the action that brings the deployed code to the local code,
+// refusing the updates Cadence would reject, like removing fields or changing their types.
+func Plan(address flow.Address, deployed string, local string) (Action, error) {
+	newProgram, err := parser2.ParseProgram(local, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse local contract: %w", err)
+	}
+	if deployed == "" {
+		return ActionDeploy, nil
+	}
+	if deployed == local {
+		return ActionUpToDate, nil
+	}
+	oldProgram, err := parser2.ParseProgram(deployed, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse deployed contract: %w", err)
+	}
+	location := common.AddressLocation{Address: common.Address(address), Name: contractName}
+	if err := runtime.NewContractUpdateValidator(location, contractName, oldProgram, newProgram).Validate(); err != nil {
+		return "", fmt.Errorf("%w: %s", ErrIncompatibleUpdate, err.Error())
+	}
+	return ActionUpdate, nil
+}
+
+func defaultProfile() string {
+	if os.Getenv("SCOPE") == "" {
+		return utils.Development
+	}
+	return utils.CalculateProfile()
+}
+
+// Diff returns the changed lines between two versions of a contract, with two lines of context.
+func Diff(old string, new string) string {
+	a := strings.Split(old, "\n")
+	b := strings.Split(new, "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	type line struct {
+		op   byte
+		text string
+		at   int
+	}
+	lines := []line{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, line{' ', a[i], j + 1})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, line{'-', a[i], j + 1})
+			i++
+		default:
+			lines = append(lines, line{'+', b[j], j + 1})
+			j++
+		}
+	}
+
+	const contextLines = 2
+	var diff strings.Builder
+	last := -1
+	for k, l := range lines {
+		if l.op == ' ' || k <= last {
+			continue
+		}
+		from := k - contextLines
+		if from <= last {
+			from = last + 1
+		} else {
+			if from < 0 {
+				from = 0
+			}
+			fmt.Fprintf(&diff, "@@ line %d\n", lines[from].at)
+		}
+		to := k + contextLines
+		for n := k + 1; n < len(lines) && n <= to; n++ {
+			if lines[n].op != ' ' {
+				to = n + contextLines
+			}
+		}
+		if to >= len(lines) {
+			to = len(lines) - 1
+		}
+		for n := from; n <= to; n++ {
+			fmt.Fprintf(&diff, "%c %s\n", lines[n].op, lines[n].text)
+		}
+		last = to
+	}
+	return strings.TrimSuffix(diff.String(), "\n")
+}
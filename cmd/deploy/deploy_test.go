@@ -0,0 +1,81 @@
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deployedContract = `pub contract PiggyBanks {
+    pub var totalSupply: UInt64
+
+    init() {
+        self.totalSupply = 0
+    }
+}`
+
+func TestPlan(t *testing.T) {
+	address := flow.HexToAddress("36e55122ece3464c")
+
+	action, err := Plan(address, "", deployedContract)
+	require.NoError(t, err)
+	assert.Equal(t, ActionDeploy, action)
+
+	action, err = Plan(address, deployedContract, deployedContract)
+	require.NoError(t, err)
+	assert.Equal(t, ActionUpToDate, action)
+
+	withFunction := `pub contract PiggyBanks {
+    pub var totalSupply: UInt64
+
+    pub fun getTotalSupply(): UInt64 {
+        return self.totalSupply
+    }
+
+    init() {
+        self.totalSupply = 0
+    }
+}`
+	action, err = Plan(address, deployedContract, withFunction)
+	require.NoError(t, err)
+	assert.Equal(t, ActionUpdate, action)
+
+	withNewField := `pub contract PiggyBanks {
+    pub var totalSupply: UInt64
+    pub var nextPiggyID: UInt32
+
+    init() {
+        self.totalSupply = 0
+        self.nextPiggyID = 1
+    }
+}`
+	_, err = Plan(address, deployedContract, withNewField)
+	assert.True(t, errors.Is(err, ErrIncompatibleUpdate))
+
+	_, err = Plan(address, deployedContract, "pub contract PiggyBanks {")
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	old := "a\nb\nc\nd\ne\nf\ng\nh"
+	new := "a\nb\nc\nD\ne\nf\ng\nh\ni"
+
+	assert.Equal(t, "@@ line 2\n  b\n  c\n- d\n+ D\n  e\n  f\n@@ line 7\n  g\n  h\n+ i", Diff(old, new))
+	assert.Equal(t, "", Diff(old, old))
+}
+
+func TestPiggyCodeImports(t *testing.T) {
+	config, err := configuration.LoadFlowConfig("../../flow.json")
+	require.NoError(t, err)
+	env := flowUtils.NewEnv("test", config)
+	code := string(flowUtils.PiggyCode(env))
+
+	assert.Contains(t, code, "import NonFungibleToken from 0x"+env.NonFungibleTokenAddress)
+	assert.Contains(t, code, "import MetadataViews from 0x"+env.MetadataViewsAddress)
+	assert.Contains(t, code, "import FungibleToken from 0x"+env.FungibleTokenAddress)
+}
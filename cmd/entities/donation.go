@@ -1,16 +1,119 @@
 package entities
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/jinzhu/gorm"
 )
 
+type DonationStatus string
+
+const (
+	DonationPendingPayment DonationStatus = "pending_payment"
+	DonationPaid           DonationStatus = "paid"
+	DonationMinting        DonationStatus = "minting"
+	DonationMinted         DonationStatus = "minted"
+	DonationFailed         DonationStatus = "failed"
+	DonationRefunded       DonationStatus = "refunded"
+)
+
+// donationTransitions lists the statuses a donation can move to from each status.
+// A failed donation can be paid again when the payment is retried, or minted again when it was already paid.
+var donationTransitions = map[DonationStatus][]DonationStatus{
+	DonationPendingPayment: {DonationPaid, DonationFailed},
+	DonationPaid:           {DonationMinting, DonationRefunded},
+	DonationMinting:        {DonationMinted, DonationFailed},
+	DonationMinted:         {DonationRefunded},
+	DonationFailed:         {DonationPaid, DonationMinting, DonationRefunded},
+	DonationRefunded:       {},
+}
+
 type Donation struct {
 	gorm.Model
-	PiggyID                   uint   `json:"piggy_id"`
-	Piggy                     Piggy  `json:"piggy"`
-	SenderID                  string `json:"sender_id"`
-	Comment                   string `json:"comment"`
-	Amount                    int64  `json:"amount"`
-	BrokePiggy                bool   `json:"broke"`
-	PaymentRelatedTransaction string `json:"transaction_id"`
+	PiggyID                   uint           `json:"piggy_id"`
+	Piggy                     Piggy          `json:"piggy"`
+	SenderID                  string         `json:"sender_id"`
+	SenderUserID              string         `gorm:"index" json:"sender_user_id"`
+	Comment                   string         `json:"comment"`
+	Amount                    int64          `json:"amount"`
+	BrokePiggy                bool           `json:"broke"`
+	PaymentRelatedTransaction string         `json:"transaction_id"`
+	NftID                     uint64         `gorm:"index" json:"nft_id"`
+	SerialNumber              uint32         `json:"serial_number"`
+	OwnerAddress              string         `json:"owner_address"`
+	Destroyed                 bool           `json:"destroyed"`
+	Status                    DonationStatus `gorm:"index" json:"status"`
+	FailureReason             string         `json:"failure_reason"`
+	StatusUpdatedAt           *time.Time     `json:"status_updated_at"`
+	PaidAt                    *time.Time     `json:"paid_at"`
+	MintStartedAt             *time.Time     `json:"mint_started_at"`
+	MintedAt                  *time.Time     `json:"minted_at"`
+	FailedAt                  *time.Time     `json:"failed_at"`
+	RefundedAt                *time.Time     `json:"refunded_at"`
+}
+
+func IsDonationStatus(status string) bool {
+	_, ok := donationTransitions[DonationStatus(status)]
+	return ok
+}
+
+// CanTransitionTo reports whether the donation can move to status.
+func (d *Donation) CanTransitionTo(status DonationStatus) bool {
+	from := d.Status
+	if from == "" {
+		from = DonationPendingPayment
+	}
+	for _, allowed := range donationTransitions[from] {
+		if allowed != status {
+			continue
+		}
+		// Minting again after a failure is only possible when the donation was paid.
+		return !(from == DonationFailed && status == DonationMinting && d.PaidAt == nil)
+	}
+	return false
+}
+
+// TransitionTo moves the donation to status and stamps the matching audit timestamp.
+func (d *Donation) TransitionTo(status DonationStatus) error {
+	if !d.CanTransitionTo(status) {
+		return fmt.Errorf("donation cannot move from %s to %s", d.Status, status)
+	}
+	now := time.Now().UTC()
+	switch status {
+	case DonationPaid:
+		d.PaidAt = &now
+	case DonationMinting:
+		d.MintStartedAt = &now
+	case DonationMinted:
+		d.MintedAt = &now
+	case DonationFailed:
+		d.FailedAt = &now
+	case DonationRefunded:
+		d.RefundedAt = &now
+	}
+	if status != DonationFailed {
+		d.FailureReason = ""
+	}
+	d.Status = status
+	d.StatusUpdatedAt = &now
+	return nil
+}
+
+// IsSentBy reports whether user made the donation. The donations minted outside the api,
+// without a sender user ID, belong to the user whose verified flow address they were minted to.
+func (d *Donation) IsSentBy(user *User) bool {
+	if d.SenderUserID != "" {
+		return d.SenderUserID == user.ID
+	}
+	return user.HasAddress(d.SenderID)
+}
+
+// Fail moves the donation to failed, keeping the reason for support.
+func (d *Donation) Fail(reason string) error {
+	if err := d.TransitionTo(DonationFailed); err != nil {
+		return err
+	}
+	d.FailureReason = reason
+	return nil
 }
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDonationLifecycle(t *testing.T) {
+	donation := Donation{Status: DonationPendingPayment}
+
+	require.NoError(t, donation.TransitionTo(DonationPaid))
+	assert.NotNil(t, donation.PaidAt)
+	require.NoError(t, donation.TransitionTo(DonationMinting))
+	assert.NotNil(t, donation.MintStartedAt)
+	require.NoError(t, donation.TransitionTo(DonationMinted))
+	assert.NotNil(t, donation.MintedAt)
+	require.NoError(t, donation.TransitionTo(DonationRefunded))
+	assert.NotNil(t, donation.RefundedAt)
+	assert.Equal(t, DonationRefunded, donation.Status)
+	assert.Equal(t, donation.RefundedAt, donation.StatusUpdatedAt)
+
+	assert.Error(t, donation.TransitionTo(DonationPaid))
+}
+
+func TestDonationInvalidTransitions(t *testing.T) {
+	donation := Donation{Status: DonationPendingPayment}
+	assert.Error(t, donation.TransitionTo(DonationMinting))
+	assert.Error(t, donation.TransitionTo(DonationMinted))
+	assert.Error(t, donation.TransitionTo(DonationRefunded))
+	assert.Equal(t, DonationPendingPayment, donation.Status)
+	assert.Nil(t, donation.StatusUpdatedAt)
+}
+
+func TestDonationRetryAfterFailure(t *testing.T) {
+	unpaid := Donation{Status: DonationPendingPayment}
+	require.NoError(t, unpaid.Fail("card declined"))
+	assert.Equal(t, "card declined", unpaid.FailureReason)
+	assert.False(t, unpaid.CanTransitionTo(DonationMinting))
+	require.NoError(t, unpaid.TransitionTo(DonationPaid))
+	assert.Empty(t, unpaid.FailureReason)
+
+	paid := Donation{Status: DonationPendingPayment}
+	require.NoError(t, paid.TransitionTo(DonationPaid))
+	require.NoError(t, paid.TransitionTo(DonationMinting))
+	require.NoError(t, paid.Fail("transaction expired"))
+	assert.True(t, paid.CanTransitionTo(DonationMinting))
+}
+
+func TestDonationIsSentBy(t *testing.T) {
+	sender := &User{ID: "sender-uid", FlowAddress: "01cf0e2f2f715450", FlowAddressVerified: true}
+	other := &User{ID: "other-uid", FlowAddress: "179b6b1cb6755e31", FlowAddressVerified: true}
+
+	donation := Donation{SenderID: other.FlowAddress, SenderUserID: sender.ID}
+	assert.True(t, donation.IsSentBy(sender))
+	assert.False(t, donation.IsSentBy(other))
+
+	// minted outside the api, it belongs to the user it was minted to
+	indexed := Donation{SenderID: sender.FlowAddress}
+	assert.True(t, indexed.IsSentBy(sender))
+	assert.False(t, indexed.IsSentBy(other))
+	// an address the server neither assigned nor verified proves nothing
+	assert.False(t, indexed.IsSentBy(&User{ID: "claimer-uid", FlowAddress: sender.FlowAddress}))
+	assert.False(t, (&Donation{}).IsSentBy(&User{ID: "no-account"}))
+}
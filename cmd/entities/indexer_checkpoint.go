@@ -0,0 +1,12 @@
+package entities
+
+import (
+	"time"
+)
+
+// IndexerCheckpoint stores the last block height whose events were applied by an indexer.
+type IndexerCheckpoint struct {
+	Name      string    `gorm:"primary_key" json:"name"`
+	Height    uint64    `json:"height"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
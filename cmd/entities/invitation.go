@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationExpired  InvitationStatus = "expired"
+)
+
+// Invitation is an email sign-in link a user sent a friend to donate to one of its piggies.
+type Invitation struct {
+	gorm.Model
+	InviterID  string           `gorm:"index" json:"inviter_id"`
+	Email      string           `gorm:"index" json:"email"`
+	FirstName  string           `json:"first_name"`
+	LastName   string           `json:"last_name"`
+	PiggyID    uint             `json:"piggy_id"`
+	Status     InvitationStatus `gorm:"index" json:"status"`
+	ExpiresAt  time.Time        `json:"expires_at"`
+	AcceptedBy string           `json:"accepted_by"`
+	AcceptedAt *time.Time       `json:"accepted_at"`
+}
+
+// Expire moves a pending invitation past its expiry to expired, reporting whether it did.
+func (i *Invitation) Expire(now time.Time) bool {
+	if i.Status != InvitationPending || now.Before(i.ExpiresAt) {
+		return false
+	}
+	i.Status = InvitationExpired
+	return true
+}
+
+// Accept records that userID signed up through the invitation.
+func (i *Invitation) Accept(userID string, now time.Time) error {
+	i.Expire(now)
+	if i.Status != InvitationPending {
+		return fmt.Errorf("invitation is %s", i.Status)
+	}
+	now = now.UTC()
+	i.Status = InvitationAccepted
+	i.AcceptedBy = userID
+	i.AcceptedAt = &now
+	return nil
+}
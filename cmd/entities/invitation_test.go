@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitationAccept(t *testing.T) {
+	now := time.Now()
+	invitation := Invitation{Status: InvitationPending, ExpiresAt: now.Add(time.Hour)}
+
+	require.NoError(t, invitation.Accept("friend-uid", now))
+	assert.Equal(t, InvitationAccepted, invitation.Status)
+	assert.Equal(t, "friend-uid", invitation.AcceptedBy)
+	assert.NotNil(t, invitation.AcceptedAt)
+	assert.Error(t, invitation.Accept("other-uid", now))
+	assert.False(t, invitation.Expire(now.Add(2*time.Hour)))
+}
+
+func TestInvitationExpire(t *testing.T) {
+	now := time.Now()
+	invitation := Invitation{Status: InvitationPending, ExpiresAt: now.Add(time.Hour)}
+
+	assert.False(t, invitation.Expire(now))
+	assert.Error(t, invitation.Accept("friend-uid", now.Add(time.Hour)))
+	assert.Equal(t, InvitationExpired, invitation.Status)
+	assert.Empty(t, invitation.AcceptedBy)
+}
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// Job is a unit of blockchain work run by the background workers.
+// Payload and Result hold the JSON encoded input and output of the job.
+type Job struct {
+	gorm.Model
+	Type       string     `json:"type"`
+	Status     JobStatus  `gorm:"index" json:"status"`
+	UserID     string     `json:"user_id"`
+	Payload    string     `gorm:"type:text" json:"-"`
+	Result     string     `gorm:"type:text" json:"-"`
+	Error      string     `gorm:"type:text" json:"error"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
@@ -8,12 +8,25 @@ import (
 
 type Piggy struct {
 	gorm.Model
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	Image       string     `json:"image"`
-	Goal        int64      `json:"goal"`
-	StartDate   time.Time  `json:"start_date"`
-	EndDate     time.Time  `json:"end_date"`
-	UserAddress string     `json:"user_address"`
-	Donations   []Donation `json:"donation"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Image           string     `json:"image"`
+	Goal            int64      `json:"goal"`
+	StartDate       time.Time  `json:"start_date"`
+	EndDate         time.Time  `json:"end_date"`
+	UserAddress     string     `json:"user_address"`
+	CreatorID       string     `gorm:"index" json:"creator_id"`
+	Broken          bool       `json:"broken"`
+	CollectedAmount int64      `json:"collected_amount"`
+	BreakerRoyalty  int64      `json:"breaker_royalty"`
+	Donations       []Donation `json:"donation"`
+}
+
+// IsOwnedBy reports whether user created the piggy. The piggies created outside the api,
+// without a creator ID, belong to the user whose verified flow address they were created with.
+func (p *Piggy) IsOwnedBy(user *User) bool {
+	if p.CreatorID != "" {
+		return p.CreatorID == user.ID
+	}
+	return user.HasAddress(p.UserAddress)
 }
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPiggyIsOwnedBy(t *testing.T) {
+	creator := &User{ID: "creator-uid", FlowAddress: "01cf0e2f2f715450", FlowAddressVerified: true}
+	other := &User{ID: "other-uid", FlowAddress: "179b6b1cb6755e31", FlowAddressVerified: true}
+
+	// the creator ID wins over the address given in the body
+	piggy := Piggy{CreatorID: creator.ID, UserAddress: other.FlowAddress}
+	assert.True(t, piggy.IsOwnedBy(creator))
+	assert.False(t, piggy.IsOwnedBy(other))
+
+	indexed := Piggy{UserAddress: creator.FlowAddress}
+	assert.True(t, indexed.IsOwnedBy(creator))
+	assert.False(t, indexed.IsOwnedBy(other))
+	// an address the server neither assigned nor verified proves nothing
+	assert.False(t, indexed.IsOwnedBy(&User{ID: "claimer-uid", FlowAddress: creator.FlowAddress}))
+	assert.False(t, (&Piggy{}).IsOwnedBy(&User{ID: "no-account"}))
+}
@@ -0,0 +1,12 @@
+package entities
+
+import (
+	"time"
+)
+
+// StripeEvent records a processed Stripe webhook event, so retried deliveries are applied only once.
+type StripeEvent struct {
+	ID        string    `gorm:"primary_key" json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+}
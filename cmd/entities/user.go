@@ -17,6 +17,10 @@ type User struct {
 	FlowAddress    string     `json:"flow_address"`
 	ExternalWallet bool       `json:"external_wallet"`
 	Status         bool       `json:"status"`
+
+	// FlowAddressVerified is set when the server created the account of FlowAddress,
+	// or the user proved it controls it by signing a wallet challenge.
+	FlowAddressVerified bool `json:"flow_address_verified"`
 }
 
 func (u *User) Disable() {
@@ -26,3 +30,13 @@ func (u *User) Disable() {
 func (u *User) Enable() {
 	u.Status = true
 }
+
+// HasAddress reports whether address is the verified flow address of u.
+func (u *User) HasAddress(address string) bool {
+	return u.FlowAddressVerified && u.FlowAddress != "" && u.FlowAddress == address
+}
+
+// IsSelf reports whether user is u, the calling user of the request acting on its own row.
+func (u *User) IsSelf(user *User) bool {
+	return u.ID != "" && u.ID == user.ID
+}
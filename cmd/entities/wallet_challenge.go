@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// WalletChallenge is a nonce a user signs with an external wallet to prove it controls the address.
+type WalletChallenge struct {
+	gorm.Model
+	UserID    string     `gorm:"index" json:"user_id"`
+	Address   string     `json:"address"`
+	Nonce     string     `gorm:"unique_index" json:"nonce"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+}
+
+// Usable reports whether the challenge can still be answered.
+func (c *WalletChallenge) Usable(now time.Time) bool {
+	return c.UsedAt == nil && now.Before(c.ExpiresAt)
+}
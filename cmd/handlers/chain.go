@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// The chain handlers read straight from the contract, so clients can check the api data.
+
+type ChainPiggyDonationsResponse struct {
+	PiggyID   uint32 `json:"piggy_id"`
+	Donations uint32 `json:"donations"`
+}
+
+type ChainCollectionResponse struct {
+	Address string   `json:"address"`
+	IDs     []uint64 `json:"ids"`
+}
+
+func ChainPiggies(ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	piggies, err := blockchainservices.GetChainPiggies(ctx, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, piggies)
+}
+
+func ChainPiggyMetadata(ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	piggyID, ok := chainPiggyID(ctx)
+	if !ok {
+		return
+	}
+	metadata, found, err := blockchainservices.GetChainPiggyMetadata(ctx, piggyID, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	if !found {
+		ctx.IndentedJSON(http.StatusNotFound, "Piggy not found")
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, metadata)
+}
+
+func ChainPiggyDonations(ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	piggyID, ok := chainPiggyID(ctx)
+	if !ok {
+		return
+	}
+	count, err := blockchainservices.GetChainPiggyDonations(ctx, piggyID, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, ChainPiggyDonationsResponse{PiggyID: piggyID, Donations: count})
+}
+
+func ChainCollection(ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	address, ok := chainAddress(ctx)
+	if !ok {
+		return
+	}
+	ids, found, err := blockchainservices.GetChainCollectionIDs(ctx, address, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	if !found {
+		ctx.IndentedJSON(http.StatusNotFound, "Donation collection not found")
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, ChainCollectionResponse{Address: address.Hex(), IDs: ids})
+}
+
+func ChainDonation(ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	address, nftID, ok := chainDonationParams(ctx)
+	if !ok {
+		return
+	}
+	donation, err := blockchainservices.GetChainDonation(ctx, address, nftID, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	if donation == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, donation)
+}
+
+func ChainDonationDisplay(ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	address, nftID, ok := chainDonationParams(ctx)
+	if !ok {
+		return
+	}
+	display, err := blockchainservices.GetChainDonationDisplay(ctx, address, nftID, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	if display == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, display)
+}
+
+func chainPiggyID(ctx *gin.Context) (uint32, bool) {
+	piggyID, err := strconv.ParseUint(ctx.Param("piggy_id"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, "piggy id is not a number")
+		return 0, false
+	}
+	return uint32(piggyID), true
+}
+
+func chainAddress(ctx *gin.Context) (flow.Address, bool) {
+	address := ctx.Param("address")
+	if !isFlowAddress(address) {
+		ctx.IndentedJSON(http.StatusBadRequest, "address is not a flow address")
+		return flow.EmptyAddress, false
+	}
+	return flow.HexToAddress(address), true
+}
+
+func chainDonationParams(ctx *gin.Context) (flow.Address, uint64, bool) {
+	address, ok := chainAddress(ctx)
+	if !ok {
+		return flow.EmptyAddress, 0, false
+	}
+	nftID, err := strconv.ParseUint(ctx.Param("nft_id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, "donation id is not a number")
+		return flow.EmptyAddress, 0, false
+	}
+	return address, nftID, true
+}
@@ -1,22 +1,30 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jinzhu/gorm"
 	"github.com/manubidegain/piggy-api/cmd/api/configuration"
 	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
 	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stripe/stripe-go/client"
 )
 
 func GetAllUserDonations(db *gorm.DB, ctx *gin.Context) {
-	userId := ctx.GetString("userID")
+	userId := ctx.GetString("UUID")
 	donations := []entities.Donation{}
 
-	if err := db.Preload("Piggy").Find(&donations, "sender_id = ?", userId).Error; err != nil {
+	if err := db.Preload("Piggy").Find(&donations, "sender_user_id = ?", userId).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 
 	ctx.IndentedJSON(http.StatusOK, donations)
@@ -32,24 +40,281 @@ func GetDonation(db *gorm.DB, ctx *gin.Context) {
 	ctx.IndentedJSON(http.StatusOK, donation)
 }
 
-func CreateDonation(db *gorm.DB, ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string, log *log.Logger, projectConfig *configuration.ProjectConfig) {
-	model := entities.Donation{}
+type DonationPayment struct {
+	Donation     entities.Donation `json:"donation"`
+	ClientSecret string            `json:"client_secret"`
+}
+
+func CreateDonation(db *gorm.DB, ctx *gin.Context, stripeClient *client.API) {
 	donation := entities.Donation{}
 	if err := ctx.BindJSON(&donation); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
+	piggy := getPiggy(db, fmt.Sprint(donation.PiggyID))
+	if piggy == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Piggy not found")
+		return
+	}
+	if piggy.Broken {
+		ctx.IndentedJSON(http.StatusConflict, "Piggy is broken, it does not accept donations")
+		return
+	}
+	donation = entities.Donation{
+		PiggyID:      donation.PiggyID,
+		SenderID:     donation.SenderID,
+		SenderUserID: ctx.GetString("UUID"),
+		Comment:      donation.Comment,
+		Amount:       donation.Amount,
+		Status:       entities.DonationPendingPayment,
+	}
+	if err := db.Create(&donation).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	charge := utils.ChargeJSON{Amount: donation.Amount, ReceiptEmail: ctx.GetString("userEmail")}
+	paymentIntent, err := CreatePaymentIntent(stripeClient, charge, &donation)
+	if err != nil {
+		donation.Fail(err.Error())
+		db.Save(&donation)
+		ctx.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+	donation.PaymentRelatedTransaction = paymentIntent.ID
+	if err := db.Save(&donation).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusCreated, DonationPayment{Donation: donation, ClientSecret: paymentIntent.ClientSecret})
+}
+
+// ConfirmDonation queues the minting of the donation NFT once its payment has been charged.
+func ConfirmDonation(db *gorm.DB, ctx *gin.Context, stripeClient *client.API, queue *jobs.Queue) {
+	id := ctx.Param("donation_id")
+	donation := getDonation(db, id)
+	if donation == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
+		return
+	}
+	if !authorizeOwner(db, ctx, donation.IsSentBy) {
+		return
+	}
+	switch donation.Status {
+	case entities.DonationMinted:
+		ctx.IndentedJSON(http.StatusOK, donation)
+		return
+	case entities.DonationMinting, entities.DonationRefunded:
+		ctx.IndentedJSON(http.StatusConflict, fmt.Sprintf("donation is %s", donation.Status))
+		return
+	}
+	if donation.PaidAt == nil {
+		paymentIntent, err := GetPaymentIntent(stripeClient, donation.PaymentRelatedTransaction)
+		if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+		if !PaymentConfirmed(paymentIntent, donation.Amount) {
+			ctx.IndentedJSON(http.StatusPaymentRequired, fmt.Sprintf("payment is %s", paymentIntent.Status))
+			return
+		}
+		if err := donation.TransitionTo(entities.DonationPaid); err != nil {
+			ctx.IndentedJSON(http.StatusConflict, err.Error())
+			return
+		}
+		if err := db.Save(donation).Error; err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	job, err := queue.Enqueue(MintDonationJob, ctx.GetString("UUID"), MintDonationPayload{DonationID: donation.ID})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusAccepted, newJobResponse(job))
+}
+
+// mintPaidDonation mints the NFT of a donation whose payment already succeeded and stores its on-chain ID.
+// The donation is claimed with a conditional update, so concurrent callers cannot mint it twice.
+func mintPaidDonation(db *gorm.DB, ctx context.Context, donation *entities.Donation, flowconfig *configuration.FlowConfig, profile string, log *log.Logger, projectConfig *configuration.ProjectConfig) error {
+	from := donation.Status
+	if err := donation.TransitionTo(entities.DonationMinting); err != nil {
+		return err
+	}
+	claim := db.Model(&entities.Donation{}).
+		Where("id = ? AND status = ?", donation.ID, from).
+		Updates(map[string]interface{}{
+			"status":            donation.Status,
+			"failure_reason":    donation.FailureReason,
+			"mint_started_at":   donation.MintStartedAt,
+			"status_updated_at": donation.StatusUpdatedAt,
+		})
+	if claim.Error != nil {
+		return claim.Error
+	}
+	if claim.RowsAffected == 0 {
+		return fmt.Errorf("donation %d is no longer %s", donation.ID, from)
+	}
+
 	donationId, err := blockchainservices.MintDonation(donation.SenderID, donation.Comment, donation.PiggyID, flowconfig, profile, ctx, log, projectConfig)
+	if err != nil {
+		donation.Fail(err.Error())
+		if saveErr := db.Save(donation).Error; saveErr != nil {
+			log.Println("cannot save failed donation " + fmt.Sprint(donation.ID) + ": " + saveErr.Error())
+		}
+		return err
+	}
+	donation.NftID = donationId
+	donation.OwnerAddress = donation.SenderID
+	if err := donation.TransitionTo(entities.DonationMinted); err != nil {
+		return err
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := adoptIndexedDonation(tx, donation); err != nil {
+			return err
+		}
+		return tx.Save(donation).Error
+	})
+}
+
+// adoptIndexedDonation removes the row the event indexer may have created for the NFT of donation
+// before the mint was saved, keeping the ownership it tracked.
+func adoptIndexedDonation(tx *gorm.DB, donation *entities.Donation) error {
+	indexed := entities.Donation{}
+	err := tx.Where("nft_id = ? AND id <> ? AND payment_related_transaction = ''", donation.NftID, donation.ID).First(&indexed).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	donation.SerialNumber = indexed.SerialNumber
+	donation.OwnerAddress = indexed.OwnerAddress
+	donation.Destroyed = indexed.Destroyed
+	return tx.Unscoped().Delete(&indexed).Error
+}
+
+// DonationTransferRequest names the recipient of a donation, either a user by email or any flow address.
+type DonationTransferRequest struct {
+	Email   string `json:"email"`
+	Address string `json:"address"`
+}
+
+// TransferDonation sends a minted donation from the custodial collection of the calling user to the recipient collection.
+func TransferDonation(db *gorm.DB, ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string, queue *jobs.Queue) {
+	request := DonationTransferRequest{}
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if (request.Email == "") == (request.Address == "") {
+		ctx.IndentedJSON(http.StatusBadRequest, "recipient needs either an email or an address")
+		return
+	}
+	user, ok := custodialUser(db, ctx)
+	if !ok {
+		return
+	}
+	donation := getDonation(db, ctx.Param("donation_id"))
+	if donation == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
+		return
+	}
+	if donation.NftID == 0 || donation.Destroyed {
+		ctx.IndentedJSON(http.StatusConflict, "donation is not minted")
+		return
+	}
+	if donation.OwnerAddress != user.FlowAddress {
+		ctx.IndentedJSON(http.StatusForbidden, "donation is not in the user collection")
+		return
+	}
+
+	recipient := request.Address
+	if request.Email != "" {
+		model := entities.User{}
+		if err := db.Where("email = ?", request.Email).First(&model).Error; err != nil {
+			ctx.IndentedJSON(http.StatusNotFound, "Recipient not found")
+			return
+		}
+		if model.FlowAddress == "" {
+			ctx.IndentedJSON(http.StatusConflict, "recipient account is not created yet")
+			return
+		}
+		recipient = model.FlowAddress
+	} else if !isFlowAddress(recipient) {
+		ctx.IndentedJSON(http.StatusBadRequest, "recipient is not a flow address")
+		return
+	}
+	address := flow.HexToAddress(recipient)
+	if address.Hex() == user.FlowAddress {
+		ctx.IndentedJSON(http.StatusBadRequest, "recipient already owns the donation")
+		return
+	}
+	hasCollection, err := blockchainservices.HasChainDonationCollection(ctx, address, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	if !hasCollection {
+		ctx.IndentedJSON(http.StatusUnprocessableEntity, blockchainservices.ErrNoDonationCollection.Error())
+		return
+	}
+
+	payload := TransferDonationPayload{Address: user.FlowAddress, NftID: donation.NftID, Recipient: address.Hex()}
+	job, err := queue.Enqueue(TransferDonationJob, user.ID, payload)
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	donation.ID = uint(donationId)
-	if err := db.FirstOrCreate(&model, donation).Error; err != nil {
+	ctx.IndentedJSON(http.StatusAccepted, newJobResponse(job))
+}
+
+// GetDonationsByStatus lists the donations in a status, optionally only those that have been there longer than older_than.
+func GetDonationsByStatus(db *gorm.DB, ctx *gin.Context) {
+	status := ctx.Query("status")
+	if !entities.IsDonationStatus(status) {
+		ctx.IndentedJSON(http.StatusBadRequest, "unknown donation status")
+		return
+	}
+	query := db.Where("status = ?", status)
+	if olderThan, find := ctx.GetQuery("older_than"); find {
+		duration, err := time.ParseDuration(olderThan)
+		if err != nil {
+			ctx.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+		query = query.Where("status_updated_at < ?", time.Now().UTC().Add(-duration))
+	}
+	donations := []entities.Donation{}
+	if err := query.Order("status_updated_at").Find(&donations).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	ctx.IndentedJSON(http.StatusCreated, model)
+	ctx.IndentedJSON(http.StatusOK, donations)
+}
+
+type DonationStatusCount struct {
+	Status entities.DonationStatus `json:"status"`
+	Count  int64                   `json:"count"`
+}
+
+// GetDonationStatusCounts counts the donations in each status.
+func GetDonationStatusCounts(db *gorm.DB, ctx *gin.Context) {
+	counts := []DonationStatusCount{}
+	err := db.Model(&entities.Donation{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&counts).Error
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, counts)
+}
+
+// UpdateDonationRequest holds the fields a donor edits, the rest belong to the payment and minting flow.
+type UpdateDonationRequest struct {
+	Comment string `json:"comment"`
 }
 
 func UpdateDonation(db *gorm.DB, ctx *gin.Context) {
@@ -59,13 +324,19 @@ func UpdateDonation(db *gorm.DB, ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
 		return
 	}
+	if !authorizeOwner(db, ctx, donation.IsSentBy) {
+		return
+	}
 
-	if err := ctx.BindJSON(&donation); err != nil {
+	request := UpdateDonationRequest{Comment: donation.Comment}
+	if err := ctx.BindJSON(&request); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	if err := db.Save(&donation).Error; err != nil {
+	// the piggy of the donation is never written through it
+	err := db.Model(donation).Set("gorm:save_associations", false).
+		Updates(map[string]interface{}{"comment": request.Comment}).Error
+	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -77,9 +348,14 @@ func DeleteDonation(db *gorm.DB, ctx *gin.Context) {
 	donation := getDonation(db, id)
 	if donation == nil {
 		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
+		return
+	}
+	if !authorizeOwner(db, ctx, donation.IsSentBy) {
+		return
 	}
 	if err := db.Delete(&donation).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 	ctx.IndentedJSON(http.StatusOK, id)
 }
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferDonation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := flowUtils.StartEmbeddedEmulator(ctx)
+	require.NoError(t, err)
+
+	db := newTestDB(t)
+	config := &configuration.FlowConfig{}
+	projectConfig := &configuration.ProjectConfig{}
+	profile := utils.Development
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, config, profile, logger, projectConfig)
+	queue.Start(ctx)
+
+	account := func() string {
+		address, err := blockchainservices.CreateAccount(ctx, profile, config, logger, projectConfig)
+		require.NoError(t, err)
+		return address
+	}
+	sender := entities.User{ID: "sender-uid", Email: "sender@piggy.test", FlowAddress: account(), FlowAddressVerified: true}
+	recipient := entities.User{ID: "recipient-uid", Email: "recipient@piggy.test", FlowAddress: account(), FlowAddressVerified: true}
+	pending := entities.User{ID: "pending-uid", Email: "pending@piggy.test"}
+	for _, user := range []*entities.User{&sender, &recipient, &pending} {
+		require.NoError(t, db.Create(user).Error)
+	}
+	piggyID, err := blockchainservices.CreateBlockchainPiggy(sender.FlowAddress, "Trip", "Saving for the trip", config, profile, ctx, logger)
+	require.NoError(t, err)
+	nftID, err := blockchainservices.MintDonation(sender.FlowAddress, "Good luck", uint(piggyID), config, profile, ctx, logger, projectConfig)
+	require.NoError(t, err)
+	donation := entities.Donation{SenderUserID: sender.ID, SenderID: sender.FlowAddress, NftID: nftID, OwnerAddress: sender.FlowAddress, Status: entities.DonationMinted}
+	require.NoError(t, db.Create(&donation).Error)
+
+	transfer := func(ctx *gin.Context) { TransferDonation(db, ctx, config, profile, queue) }
+	path := "/donation/" + fmt.Sprint(donation.ID) + "/transfer"
+	post := func(caller entities.User, body string) *httptest.ResponseRecorder {
+		return serve(testCaller{UID: caller.ID, Email: caller.Email}, http.MethodPost, "/donation/:donation_id/transfer", path, body, transfer)
+	}
+
+	assert.Equal(t, http.StatusBadRequest, post(sender, `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(sender, `{"email": "recipient@piggy.test", "address": "`+recipient.FlowAddress+`"}`).Code)
+	assert.Equal(t, http.StatusNotFound, post(sender, `{"email": "nobody@piggy.test"}`).Code)
+	assert.Equal(t, http.StatusConflict, post(sender, `{"email": "pending@piggy.test"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(sender, `{"address": "not-an-address"}`).Code)
+	// sending to itself, by email or by address
+	assert.Equal(t, http.StatusBadRequest, post(sender, `{"email": "sender@piggy.test"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(sender, `{"address": "0x`+sender.FlowAddress+`"}`).Code)
+	// an account nobody set up holds no donation collection
+	assert.Equal(t, http.StatusUnprocessableEntity, post(sender, `{"address": "0x00000000000000ab"}`).Code)
+	assert.Equal(t, http.StatusForbidden, post(recipient, `{"email": "sender@piggy.test"}`).Code)
+
+	recorder := post(sender, `{"email": "recipient@piggy.test"}`)
+	require.Equal(t, http.StatusAccepted, recorder.Code, recorder.Body.String())
+	job := JobResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &job))
+	require.Eventually(t, func() bool {
+		require.NoError(t, db.First(&job.Job, job.ID).Error)
+		return job.Status == entities.JobSucceeded || job.Status == entities.JobFailed
+	}, 30*time.Second, 50*time.Millisecond)
+	require.Equal(t, entities.JobSucceeded, job.Status, job.Error)
+
+	// the owner waits for the indexer
+	assert.Equal(t, sender.FlowAddress, getDonation(db, fmt.Sprint(donation.ID)).OwnerAddress)
+	ids, found, err := blockchainservices.GetChainCollectionIDs(ctx, flow.HexToAddress(recipient.FlowAddress), config, profile)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []uint64{nftID}, ids)
+	// as the indexer on the Deposit, then a transfer queued before it finds the donation gone
+	require.NoError(t, db.Model(&entities.Donation{}).Where("nft_id = ?", nftID).Update("owner_address", recipient.FlowAddress).Error)
+	queued, err := queue.Enqueue(TransferDonationJob, sender.ID, TransferDonationPayload{Address: sender.FlowAddress, NftID: nftID, Recipient: recipient.FlowAddress})
+	require.NoError(t, err)
+	job = newJobResponse(queued)
+	require.Eventually(t, func() bool {
+		require.NoError(t, db.First(&job.Job, job.ID).Error)
+		return job.Status == entities.JobSucceeded || job.Status == entities.JobFailed
+	}, 30*time.Second, 50*time.Millisecond)
+	assert.Equal(t, entities.JobFailed, job.Status)
+	// the donation left the collection of the sender
+	assert.Equal(t, http.StatusForbidden, post(sender, `{"email": "recipient@piggy.test"}`).Code)
+}
+
+func TestUpdateDonationEditsTheCommentOnly(t *testing.T) {
+	db := newTestDB(t)
+	sender := entities.User{ID: "sender-uid", Email: "sender@piggy.test"}
+	require.NoError(t, db.Create(&sender).Error)
+	mine := entities.Piggy{Name: "Trip", CreatorID: "creator-uid", CollectedAmount: 500}
+	other := entities.Piggy{Name: "Other", CreatorID: "other-uid"}
+	require.NoError(t, db.Create(&mine).Error)
+	require.NoError(t, db.Create(&other).Error)
+	donation := entities.Donation{PiggyID: mine.ID, SenderUserID: sender.ID, SenderID: "sender-id", Amount: 500, Status: entities.DonationPaid}
+	require.NoError(t, db.Create(&donation).Error)
+
+	update := func(ctx *gin.Context) { UpdateDonation(db, ctx) }
+	body := fmt.Sprintf(`{"comment": "Enjoy", "piggy_id": %d, "sender_id": "someone", "sender_user_id": "other-uid", "amount": 1,
+		"status": "minted", "piggy": {"ID": %d, "name": "Mine now", "broken": true, "creator_id": "sender-uid", "collected_amount": 1}}`, other.ID, mine.ID)
+	recorder := serve(testCaller{UID: sender.ID}, http.MethodPut, "/donation/:donation_id", "/donation/"+fmt.Sprint(donation.ID), body, update)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	saved := getDonation(db, fmt.Sprint(donation.ID))
+	assert.Equal(t, "Enjoy", saved.Comment)
+	assert.Equal(t, mine.ID, saved.PiggyID)
+	assert.Equal(t, "sender-id", saved.SenderID)
+	assert.Equal(t, sender.ID, saved.SenderUserID)
+	assert.Equal(t, int64(500), saved.Amount)
+	assert.Equal(t, entities.DonationPaid, saved.Status)
+	piggy := getPiggy(db, fmt.Sprint(mine.ID))
+	assert.Equal(t, "Trip", piggy.Name)
+	assert.False(t, piggy.Broken)
+	assert.Equal(t, "creator-uid", piggy.CreatorID)
+	assert.Equal(t, int64(500), piggy.CollectedAmount)
+
+	recorder = serve(testCaller{UID: "other-uid"}, http.MethodPut, "/donation/:donation_id", "/donation/"+fmt.Sprint(donation.ID), `{"comment": "Mine"}`, update)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
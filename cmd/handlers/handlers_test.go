@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDB returns an in-memory database with the tables of the api.
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	// every connection to :memory: opens a different database
+	db.DB().SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, db.AutoMigrate(&entities.User{}, &entities.Piggy{}, &entities.Donation{}, &entities.Job{}, &entities.WalletChallenge{}, &entities.Invitation{}, &entities.StripeEvent{}).Error)
+	return db
+}
+
+// testCaller is the authenticated user of a test request, as the auth middleware sets it.
+type testCaller struct {
+	UID   string
+	Email string
+	Roles []string
+}
+
+// serve runs handler on route for a request of caller, with a JSON body when body is not empty.
+func serve(caller testCaller, method string, route string, path string, body string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Handle(method, route, func(ctx *gin.Context) {
+		ctx.Set("UUID", caller.UID)
+		ctx.Set("userEmail", caller.Email)
+		if len(caller.Roles) > 0 {
+			ctx.Set(middlewares.MemberRolesClaim, middlewares.RolesClaim(caller.Roles))
+		}
+	}, handler)
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"firebase.google.com/go/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+)
+
+// invitationTTL is how long the friend has to sign up through an invitation.
+const invitationTTL = 7 * 24 * time.Hour
+
+type InvitationRequest struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	PiggyID   uint   `json:"piggy_id"`
+}
+
+// CreateInvitation invites a friend to donate to a piggy of the calling user, emailing a sign-in link.
+func CreateInvitation(db *gorm.DB, ctx *gin.Context, client *auth.Client, config *configuration.Config) {
+	request := InvitationRequest{}
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if _, err := mail.ParseAddress(request.Email); err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, "invitation needs a valid email")
+		return
+	}
+	user, ok := callingUser(db, ctx)
+	if !ok {
+		return
+	}
+	piggy := getPiggy(db, fmt.Sprint(request.PiggyID))
+	if piggy == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Piggy not found")
+		return
+	}
+	if !authorizeOwner(db, ctx, piggy.IsOwnedBy) {
+		return
+	}
+	if piggy.Broken {
+		ctx.IndentedJSON(http.StatusConflict, "Piggy is broken, it does not accept donations")
+		return
+	}
+
+	invitation := entities.Invitation{
+		InviterID: user.ID,
+		Email:     request.Email,
+		FirstName: request.FirstName,
+		LastName:  request.LastName,
+		PiggyID:   piggy.ID,
+		Status:    entities.InvitationPending,
+		ExpiresAt: time.Now().UTC().Add(invitationTTL),
+	}
+	if err := db.Create(&invitation).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	senderName := user.DisplayName
+	if senderName == "" {
+		senderName = user.Email
+	}
+	if err := EmailSignup(client, ctx, config, &invitation, senderName); err != nil {
+		// an invitation that never reached the friend cannot be accepted
+		db.Delete(&invitation)
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusCreated, invitation)
+}
+
+// GetUserInvitations lists the invitations the calling user sent, the newest first.
+func GetUserInvitations(db *gorm.DB, ctx *gin.Context) {
+	invitations := []entities.Invitation{}
+	err := db.Where("inviter_id = ?", ctx.GetString("UUID")).Order("created_at DESC").Find(&invitations).Error
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	now := time.Now()
+	for i := range invitations {
+		invitations[i].Expire(now)
+	}
+	ctx.IndentedJSON(http.StatusOK, invitations)
+}
+
+// acceptInvitations marks the pending invitations sent to email as accepted by user,
+// and the ones past their expiry as expired. email must be the verified email of the user token.
+func acceptInvitations(db *gorm.DB, user *entities.User, email string) error {
+	if email == "" {
+		return nil
+	}
+	invitations := []entities.Invitation{}
+	if err := db.Where("email = ? AND status = ?", email, entities.InvitationPending).Find(&invitations).Error; err != nil {
+		return err
+	}
+	now := time.Now()
+	return db.Transaction(func(tx *gorm.DB) error {
+		for i := range invitations {
+			if !invitations[i].Expire(now) {
+				if err := invitations[i].Accept(user.ID, now); err != nil {
+					return err
+				}
+			}
+			if err := tx.Save(&invitations[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitationURL(t *testing.T) {
+	invitation := &entities.Invitation{
+		InviterID: "inviter-uid",
+		Email:     "ana+piggy@piggy.test",
+		FirstName: "Ana María",
+		LastName:  "O'Neil&Co",
+		PiggyID:   7,
+	}
+	invitation.ID = 3
+
+	link := invitationURL("http://localhost:3000/", invitation, "Juan & Sofía")
+	require.True(t, strings.HasPrefix(link, "http://localhost:3000/member-login?"))
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, "ana+piggy@piggy.test", query.Get("email"))
+	assert.Equal(t, "Ana María", query.Get("first_name"))
+	assert.Equal(t, "O'Neil&Co", query.Get("last_name"))
+	assert.Equal(t, "Juan & Sofía", query.Get("invited_by"))
+	assert.Equal(t, "inviter-uid", query.Get("user_id"))
+	assert.Equal(t, "3", query.Get("invitation_id"))
+	assert.Equal(t, "7", query.Get("piggy_id"))
+}
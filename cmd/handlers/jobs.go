@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+)
+
+// Job types run by the background workers.
+const (
+	CreateAccountJob    = "create_account"
+	CreatePiggyJob      = "create_piggy"
+	MintDonationJob     = "mint_donation"
+	SetupWalletJob      = "setup_wallet"
+	TransferDonationJob = "transfer_donation"
+)
+
+type CreateAccountPayload struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+type MintDonationPayload struct {
+	DonationID uint `json:"donation_id"`
+}
+
+type JobResponse struct {
+	entities.Job
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// RegisterJobHandlers sets the handlers of every blockchain job type.
+func RegisterJobHandlers(queue *jobs.Queue, db *gorm.DB, flowconfig *configuration.FlowConfig, profile string, log *log.Logger, projectConfig *configuration.ProjectConfig) {
+	queue.Register(CreateAccountJob, func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		payload := CreateAccountPayload{}
+		if err := jobs.DecodePayload(job, &payload); err != nil {
+			return nil, err
+		}
+		// the jobs queued before the payload had the user carry it in the job
+		userID := payload.UserID
+		if userID == "" {
+			userID = job.UserID
+		}
+		user := getUser(db, userID)
+		if user == nil {
+			return nil, fmt.Errorf("user %s not found", userID)
+		}
+		if user.ExternalWallet || user.FlowAddress != "" {
+			return gin.H{"flow_address": user.FlowAddress}, nil
+		}
+		flowAddress, err := blockchainservices.CreateAccount(ctx, profile, flowconfig, log, projectConfig)
+		if err != nil {
+			return nil, err
+		}
+		// a wallet linked while the account was created wins, the new account is left unused
+		update := db.Model(&entities.User{}).
+			Where("id = ? AND flow_address = '' AND external_wallet = ?", userID, false).
+			Updates(map[string]interface{}{"flow_address": flowAddress, "flow_address_verified": true})
+		if update.Error != nil {
+			return nil, update.Error
+		}
+		if update.RowsAffected == 0 {
+			return nil, fmt.Errorf("user %s got an account meanwhile, created account %s is unused", userID, flowAddress)
+		}
+		return gin.H{"flow_address": flowAddress}, nil
+	})
+
+	queue.Register(CreatePiggyJob, func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		piggy := entities.Piggy{}
+		if err := jobs.DecodePayload(job, &piggy); err != nil {
+			return nil, err
+		}
+		piggyId, err := blockchainservices.CreateBlockchainPiggy(piggy.UserAddress, piggy.Name, piggy.Description, flowconfig, profile, ctx, log)
+		if err != nil {
+			return nil, err
+		}
+		// the row is keyed by the on-chain id, the event indexer may have stored it already.
+		// Only the profile of the payload is stored, the chain and the payments own the rest.
+		model := entities.Piggy{}
+		err = db.Where(map[string]interface{}{"id": piggyId}).
+			Assign(map[string]interface{}{
+				"name":         piggy.Name,
+				"description":  piggy.Description,
+				"image":        piggy.Image,
+				"goal":         piggy.Goal,
+				"start_date":   piggy.StartDate,
+				"end_date":     piggy.EndDate,
+				"user_address": piggy.UserAddress,
+				"creator_id":   piggy.CreatorID,
+			}).FirstOrCreate(&model).Error
+		if err != nil {
+			return nil, err
+		}
+		return model, nil
+	})
+
+	queue.Register(MintDonationJob, func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		payload := MintDonationPayload{}
+		if err := jobs.DecodePayload(job, &payload); err != nil {
+			return nil, err
+		}
+		donation := getDonation(db, fmt.Sprint(payload.DonationID))
+		if donation == nil {
+			return nil, fmt.Errorf("donation %d not found", payload.DonationID)
+		}
+		if err := mintPaidDonation(db, ctx, donation, flowconfig, profile, log, projectConfig); err != nil {
+			return nil, err
+		}
+		return donation, nil
+	})
+
+	queue.Register(SetupWalletJob, func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		payload := WalletPayload{}
+		if err := jobs.DecodePayload(job, &payload); err != nil {
+			return nil, err
+		}
+		if err := blockchainservices.SetupUserAccount(ctx, payload.Address, flowconfig, profile, log, projectConfig); err != nil {
+			return nil, err
+		}
+		return payload, nil
+	})
+
+	queue.Register(TransferDonationJob, func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		payload := TransferDonationPayload{}
+		if err := jobs.DecodePayload(job, &payload); err != nil {
+			return nil, err
+		}
+		// the donation may have moved since the job was queued
+		donation := entities.Donation{}
+		if err := db.Where("nft_id = ?", payload.NftID).First(&donation).Error; err != nil {
+			return nil, err
+		}
+		if donation.Destroyed || donation.OwnerAddress != payload.Address {
+			return nil, fmt.Errorf("donation %d is not in the collection of %s", payload.NftID, payload.Address)
+		}
+		// the owner is set by the indexer once the Deposit event is sealed
+		err := blockchainservices.TransferUserDonation(ctx, payload.Address, payload.NftID, payload.Recipient, flowconfig, profile, log, projectConfig)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	})
+}
+
+func GetJob(db *gorm.DB, ctx *gin.Context) {
+	id := ctx.Param("job_id")
+	job := entities.Job{}
+	if err := db.First(&job, id).Error; err != nil || job.UserID != ctx.GetString("UUID") {
+		ctx.IndentedJSON(http.StatusNotFound, "Job not found")
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, newJobResponse(&job))
+}
+
+func newJobResponse(job *entities.Job) JobResponse {
+	response := JobResponse{Job: *job}
+	if job.Result != "" {
+		response.Result = json.RawMessage(job.Result)
+	}
+	return response
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAccountJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := flowUtils.StartEmbeddedEmulator(ctx)
+	require.NoError(t, err)
+
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	queue.Start(ctx)
+
+	// phone users have no email, the account goes to the user of the job only
+	phone := entities.User{ID: "phone-uid"}
+	external := entities.User{ID: "external-uid", Email: "external@piggy.test", FlowAddress: "179b6b1cb6755e31", ExternalWallet: true}
+	require.NoError(t, db.Create(&phone).Error)
+	require.NoError(t, db.Create(&external).Error)
+	require.NoError(t, db.Exec("INSERT INTO users (id, email) VALUES (?, ?)", "other-uid", "other@piggy.test").Error)
+
+	run := func(userID string) *entities.Job {
+		job, err := queue.Enqueue(CreateAccountJob, userID, CreateAccountPayload{UserID: userID})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			require.NoError(t, db.First(job, job.ID).Error)
+			return job.Status == entities.JobSucceeded || job.Status == entities.JobFailed
+		}, 30*time.Second, 50*time.Millisecond)
+		return job
+	}
+
+	job := run(phone.ID)
+	require.Equal(t, entities.JobSucceeded, job.Status, job.Error)
+	address := getUser(db, phone.ID).FlowAddress
+	assert.NotEmpty(t, address)
+	assert.True(t, getUser(db, phone.ID).FlowAddressVerified)
+	assert.Empty(t, getUser(db, "other-uid").FlowAddress)
+
+	// a second job keeps the account instead of orphaning it
+	job = run(phone.ID)
+	require.Equal(t, entities.JobSucceeded, job.Status, job.Error)
+	assert.Equal(t, address, getUser(db, phone.ID).FlowAddress)
+
+	job = run(external.ID)
+	require.Equal(t, entities.JobSucceeded, job.Status, job.Error)
+	assert.Equal(t, "179b6b1cb6755e31", getUser(db, external.ID).FlowAddress)
+}
+
+func TestCreatePiggyJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := flowUtils.StartEmbeddedEmulator(ctx)
+	require.NoError(t, err)
+
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	queue.Start(ctx)
+	// a row at the id the payload claims
+	taken := entities.Piggy{Name: "Taken"}
+	taken.ID = 999
+	require.NoError(t, db.Create(&taken).Error)
+
+	payload := entities.Piggy{Name: "Trip", Description: "Saving for the trip", Goal: 5000, UserAddress: "f8d6e0586b0a20c7", CreatorID: "creator-uid", Broken: true, CollectedAmount: 100}
+	payload.ID = taken.ID
+	job, err := queue.Enqueue(CreatePiggyJob, "creator-uid", payload)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		require.NoError(t, db.First(job, job.ID).Error)
+		return job.Status == entities.JobSucceeded || job.Status == entities.JobFailed
+	}, 30*time.Second, 50*time.Millisecond)
+	require.Equal(t, entities.JobSucceeded, job.Status, job.Error)
+
+	created := entities.Piggy{}
+	require.NoError(t, json.Unmarshal([]byte(job.Result), &created))
+	assert.NotEqual(t, taken.ID, created.ID)
+	piggy := getPiggy(db, fmt.Sprint(created.ID))
+	require.NotNil(t, piggy)
+	assert.Equal(t, "Trip", piggy.Name)
+	assert.Equal(t, int64(5000), piggy.Goal)
+	assert.Equal(t, "creator-uid", piggy.CreatorID)
+	assert.False(t, piggy.Broken)
+	assert.Zero(t, piggy.CollectedAmount)
+	assert.Equal(t, "Taken", getPiggy(db, fmt.Sprint(taken.ID)).Name)
+}
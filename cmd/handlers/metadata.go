@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/certificates"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+)
+
+// The urls the PiggyBanks contract builds in NFT.resolveView.
+const (
+	donationURL     = "https://gopiggy.com/donation/"
+	donationAssets  = "https://assets.gopiggy.com/media/"
+	collectionURL   = "https://gopiggy.com"
+	collectionName  = "PiggyBanks"
+	collectionAbout = "Piggy Bank is to chance to change someone life while you get a valuable NFT and a chance to obtain more than your gift!!!"
+)
+
+type MetadataFile struct {
+	URL string `json:"url"`
+}
+
+type MetadataMedia struct {
+	File      MetadataFile `json:"file"`
+	MediaType string       `json:"media_type"`
+}
+
+type MetadataDisplay struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Thumbnail   MetadataFile `json:"thumbnail"`
+}
+
+type MetadataSerial struct {
+	Number uint64 `json:"number"`
+}
+
+type MetadataCollectionDisplay struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	ExternalURL MetadataFile            `json:"external_url"`
+	SquareImage MetadataMedia           `json:"square_image"`
+	BannerImage MetadataMedia           `json:"banner_image"`
+	Socials     map[string]MetadataFile `json:"socials"`
+}
+
+type MetadataMedias struct {
+	Items []MetadataMedia `json:"items"`
+}
+
+type MetadataAttribute struct {
+	TraitType string      `json:"trait_type"`
+	Value     interface{} `json:"value"`
+}
+
+// DonationMetadata holds the MetadataViews resolved by a donation NFT, with the name, image
+// and attributes fields at the top level for marketplaces that do not read the Flow views.
+type DonationMetadata struct {
+	ID                   uint64                    `json:"id"`
+	Name                 string                    `json:"name"`
+	Description          string                    `json:"description"`
+	Image                string                    `json:"image"`
+	ExternalURL          string                    `json:"external_url"`
+	Attributes           []MetadataAttribute       `json:"attributes"`
+	Display              MetadataDisplay           `json:"display"`
+	Serial               MetadataSerial            `json:"serial"`
+	NFTCollectionDisplay MetadataCollectionDisplay `json:"nft_collection_display"`
+	Medias               MetadataMedias            `json:"medias"`
+}
+
+// GetDonationMetadata serves the metadata of a minted donation by NFT id, the id in the contract urls.
+func GetDonationMetadata(db *gorm.DB, ctx *gin.Context) {
+	donation, ok := mintedDonation(db, ctx, ctx.Param("donation_id"))
+	if !ok {
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, donationMetadata(*donation))
+}
+
+// GetDonationMedia serves the certificate image the contract links as the donation thumbnail and medium image.
+func GetDonationMedia(db *gorm.DB, ctx *gin.Context, renderer *certificates.Renderer) {
+	width := certificates.MediumWidth
+	if query := ctx.Query("width"); query != "" {
+		parsed, err := strconv.Atoi(query)
+		if err != nil || !certificates.ValidWidth(parsed) {
+			ctx.IndentedJSON(http.StatusBadRequest, fmt.Sprintf("width must be %d or %d", certificates.ThumbnailWidth, certificates.MediumWidth))
+			return
+		}
+		width = parsed
+	}
+	donation, ok := mintedDonation(db, ctx, ctx.Param("nft_id"))
+	if !ok {
+		return
+	}
+	card := certificates.Card{
+		NftID:        donation.NftID,
+		PiggyName:    donation.Piggy.Name,
+		PiggyImage:   donation.Piggy.Image,
+		SerialNumber: donation.SerialNumber,
+		Comment:      donation.Comment,
+	}
+	path, complete, err := renderer.Render(ctx, card, width)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if complete {
+		ctx.Header("Cache-Control", "public, max-age=86400")
+	} else {
+		// drawn without the piggy image, the next request tries it again
+		ctx.Header("Cache-Control", "no-store")
+	}
+	ctx.File(path)
+}
+
+func mintedDonation(db *gorm.DB, ctx *gin.Context, id string) (*entities.Donation, bool) {
+	nftID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || nftID == 0 {
+		ctx.IndentedJSON(http.StatusBadRequest, "donation id is not an nft id")
+		return nil, false
+	}
+	donation := entities.Donation{}
+	err = db.Preload("Piggy").Where("nft_id = ? AND destroyed = ?", nftID, false).First(&donation).Error
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Donation not found")
+		return nil, false
+	}
+	return &donation, true
+}
+
+func donationMetadata(donation entities.Donation) DonationMetadata {
+	id := strconv.FormatUint(donation.NftID, 10)
+	// name and description are built as in NFT.name and NFT.description
+	name := fmt.Sprintf("Donation number%dfor piggy with id :%d", donation.SerialNumber, donation.SerialNumber)
+	description := donation.Comment
+	if description == "" {
+		description = "No reason"
+	}
+	thumbnail := donationAssets + id + "?width=256"
+
+	return DonationMetadata{
+		ID:          donation.NftID,
+		Name:        name,
+		Description: description,
+		Image:       thumbnail,
+		ExternalURL: donationURL + id,
+		Attributes: []MetadataAttribute{
+			{TraitType: "Piggy", Value: donation.Piggy.Name},
+			{TraitType: "Serial", Value: donation.SerialNumber},
+		},
+		Display: MetadataDisplay{
+			Name:        name,
+			Description: description,
+			Thumbnail:   MetadataFile{URL: thumbnail},
+		},
+		Serial: MetadataSerial{Number: uint64(donation.SerialNumber)},
+		NFTCollectionDisplay: MetadataCollectionDisplay{
+			Name:        collectionName,
+			Description: collectionAbout,
+			ExternalURL: MetadataFile{URL: collectionURL},
+			SquareImage: MetadataMedia{
+				File:      MetadataFile{URL: "https://gopiggy.com/static/img/some-image.png"},
+				MediaType: "image/png",
+			},
+			BannerImage: MetadataMedia{
+				File:      MetadataFile{URL: "https://gopiggy.com/static/img/some-image.svg"},
+				MediaType: "image/svg+xml",
+			},
+			Socials: map[string]MetadataFile{
+				"twitter":   {URL: "https://twitter.com/gopiggy"},
+				"discord":   {URL: "https://discord.com/invite/gopiggy"},
+				"instagram": {URL: "https://www.instagram.com/gopiggy"},
+			},
+		},
+		Medias: MetadataMedias{Items: []MetadataMedia{
+			{File: MetadataFile{URL: donationAssets + id + "?width=512"}, MediaType: "image/jpeg"},
+			{File: MetadataFile{URL: donationAssets + id + "/video"}, MediaType: "video/mp4"},
+		}},
+	}
+}
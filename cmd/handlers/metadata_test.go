@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDonationMetadata(t *testing.T) {
+	donation := entities.Donation{NftID: 12, SerialNumber: 3, Piggy: entities.Piggy{Name: "Trip"}}
+
+	metadata := donationMetadata(donation)
+
+	assert.Equal(t, uint64(12), metadata.ID)
+	assert.Equal(t, "No reason", metadata.Display.Description)
+	assert.Equal(t, "https://assets.gopiggy.com/media/12?width=256", metadata.Display.Thumbnail.URL)
+	assert.Equal(t, "https://gopiggy.com/donation/12", metadata.ExternalURL)
+	assert.Equal(t, uint64(3), metadata.Serial.Number)
+	assert.Equal(t, "https://assets.gopiggy.com/media/12?width=512", metadata.Medias.Items[0].File.URL)
+	assert.Equal(t, "https://assets.gopiggy.com/media/12/video", metadata.Medias.Items[1].File.URL)
+	assert.Equal(t, "Trip", metadata.Attributes[0].Value)
+
+	donation.Comment = "For the trip"
+	assert.Equal(t, "For the trip", donationMetadata(donation).Description)
+}
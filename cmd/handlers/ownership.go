@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+)
+
+// isAdmin reports whether the calling member has the admin role.
+func isAdmin(ctx *gin.Context) bool {
+	for _, role := range middlewares.MemberRoles(ctx) {
+		if role == middlewares.AdminRole {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeOwner lets admins and the calling user when owns it through, responding 403 to anyone else.
+func authorizeOwner(db *gorm.DB, ctx *gin.Context, owns func(user *entities.User) bool) bool {
+	if isAdmin(ctx) {
+		return true
+	}
+	id := ctx.GetString("UUID")
+	if id != "" {
+		if user := getUserByToken(db, id); user != nil && owns(user) {
+			return true
+		}
+	}
+	ctx.IndentedJSON(http.StatusForbidden, "user does not own the resource")
+	return false
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizeOwner(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	owns := func(user *entities.User) bool { return false }
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Set(middlewares.MemberRolesClaim, middlewares.RolesClaim([]string{middlewares.AdminRole}))
+	assert.True(t, authorizeOwner(nil, ctx, owns))
+
+	recorder = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(recorder)
+	ctx.Set(middlewares.MemberRolesClaim, middlewares.RolesClaim([]string{middlewares.CreatorRole}))
+	assert.False(t, authorizeOwner(nil, ctx, owns))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
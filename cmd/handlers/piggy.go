@@ -3,12 +3,14 @@ package handlers
 import (
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jinzhu/gorm"
 	"github.com/manubidegain/piggy-api/cmd/api/configuration"
 	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
 	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
 )
 
 func GetAllPiggies(db *gorm.DB, ctx *gin.Context) {
@@ -31,24 +33,42 @@ func GetPiggy(db *gorm.DB, ctx *gin.Context) {
 	ctx.IndentedJSON(http.StatusOK, piggy)
 }
 
-func CreatePiggy(db *gorm.DB, ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string, log *log.Logger) {
-	model := entities.Piggy{}
+func CreatePiggy(db *gorm.DB, ctx *gin.Context, queue *jobs.Queue) {
 	piggy := entities.Piggy{}
 	if err := ctx.BindJSON(&piggy); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	piggyId, err := blockchainservices.CreateBlockchainPiggy(piggy.UserAddress, piggy.Name, piggy.Description, flowconfig, profile, ctx, log)
-	if err != nil {
-		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
-		return
+	piggy.CreatorID = ctx.GetString("UUID")
+	// admins may create piggies on any account, creators on their own
+	if !isAdmin(ctx) {
+		user, ok := callingUser(db, ctx)
+		if !ok {
+			return
+		}
+		if user.FlowAddress == "" {
+			ctx.IndentedJSON(http.StatusConflict, "user account is not created yet")
+			return
+		}
+		piggy.UserAddress = user.FlowAddress
 	}
-	piggy.ID = uint(piggyId)
-	if err := db.FirstOrCreate(&model, piggy).Error; err != nil {
+	job, err := queue.Enqueue(CreatePiggyJob, ctx.GetString("UUID"), piggy)
+	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	ctx.IndentedJSON(http.StatusCreated, model)
+	ctx.IndentedJSON(http.StatusAccepted, newJobResponse(job))
+}
+
+// UpdatePiggyRequest holds the fields a creator edits. Breaking the piggy, the collected amount
+// and the donations only change through the contract and the payment flow.
+type UpdatePiggyRequest struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Image       string    `json:"image"`
+	Goal        int64     `json:"goal"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
 }
 
 func UpdatePiggy(db *gorm.DB, ctx *gin.Context) {
@@ -58,13 +78,33 @@ func UpdatePiggy(db *gorm.DB, ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusNotFound, "Piggy not found")
 		return
 	}
+	if !authorizeOwner(db, ctx, piggy.IsOwnedBy) {
+		return
+	}
 
-	if err := ctx.BindJSON(&piggy); err != nil {
+	// the fields left out of the body keep their value
+	request := UpdatePiggyRequest{
+		Name:        piggy.Name,
+		Description: piggy.Description,
+		Image:       piggy.Image,
+		Goal:        piggy.Goal,
+		StartDate:   piggy.StartDate,
+		EndDate:     piggy.EndDate,
+	}
+	if err := ctx.BindJSON(&request); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	if err := db.Save(&piggy).Error; err != nil {
+	updates := map[string]interface{}{
+		"name":        request.Name,
+		"description": request.Description,
+		"image":       request.Image,
+		"goal":        request.Goal,
+		"start_date":  request.StartDate,
+		"end_date":    request.EndDate,
+	}
+	// the donations of the piggy are never written through it
+	if err := db.Model(piggy).Set("gorm:save_associations", false).Updates(updates).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -76,13 +116,77 @@ func DeletePiggy(db *gorm.DB, ctx *gin.Context) {
 	piggy := getPiggy(db, id)
 	if piggy == nil {
 		ctx.IndentedJSON(http.StatusNotFound, "Piggy not found")
+		return
+	}
+	if !authorizeOwner(db, ctx, piggy.IsOwnedBy) {
+		return
 	}
 	if err := db.Delete(&piggy).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 	ctx.IndentedJSON(http.StatusOK, id)
 }
 
+type BreakPiggyRequest struct {
+	BreakerRoyalty int64 `json:"breaker_royalty"`
+}
+
+func BreakPiggy(db *gorm.DB, ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string, log *log.Logger) {
+	id := ctx.Param("piggy_id")
+	piggy := getPiggy(db, id)
+	if piggy == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Piggy not found")
+		return
+	}
+	if !authorizeOwner(db, ctx, piggy.IsOwnedBy) {
+		return
+	}
+	if piggy.Broken {
+		ctx.IndentedJSON(http.StatusConflict, "Piggy already broken")
+		return
+	}
+	breakRequest := BreakPiggyRequest{}
+	if err := ctx.BindJSON(&breakRequest); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	collected, err := collectedAmount(db, piggy.ID)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if breakRequest.BreakerRoyalty < 0 || breakRequest.BreakerRoyalty > collected {
+		ctx.IndentedJSON(http.StatusBadRequest, "breaker royalty must be between zero and the collected amount")
+		return
+	}
+	err = blockchainservices.BreakBlockchainPiggy(piggy.ID, uint64(collected), uint64(breakRequest.BreakerRoyalty), flowconfig, profile, ctx, log)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	piggy.Broken = true
+	piggy.CollectedAmount = collected
+	piggy.BreakerRoyalty = breakRequest.BreakerRoyalty
+	if err := db.Save(&piggy).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, piggy)
+}
+
+// collectedAmount adds up the paid donations of a piggy, in cents.
+func collectedAmount(db *gorm.DB, piggyID uint) (int64, error) {
+	var result struct {
+		Total int64
+	}
+	err := db.Model(&entities.Donation{}).
+		Select("COALESCE(SUM(amount), 0) AS total").
+		Where("piggy_id = ? AND paid_at IS NOT NULL AND status <> ?", piggyID, entities.DonationRefunded).
+		Scan(&result).Error
+	return result.Total, err
+}
+
 func getPiggy(db *gorm.DB, id string) *entities.Piggy {
 	piggy := entities.Piggy{}
 	if err := db.First(&piggy, id).Error; err != nil {
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePiggyEditsTheProfileOnly(t *testing.T) {
+	db := newTestDB(t)
+	creator := entities.User{ID: "creator-uid", Email: "creator@piggy.test"}
+	require.NoError(t, db.Create(&creator).Error)
+	piggy := entities.Piggy{Name: "Trip", Description: "Saving", Goal: 1000, CreatorID: creator.ID, Broken: true, CollectedAmount: 500, BreakerRoyalty: 50}
+	require.NoError(t, db.Create(&piggy).Error)
+	donation := entities.Donation{PiggyID: piggy.ID, Comment: "Good luck", Amount: 500}
+	require.NoError(t, db.Create(&donation).Error)
+
+	update := func(ctx *gin.Context) { UpdatePiggy(db, ctx) }
+	path := "/piggy/" + fmt.Sprint(piggy.ID)
+	body := fmt.Sprintf(`{"name": "Beach", "goal": 2000, "broken": false, "collected_amount": 1, "breaker_royalty": 0,
+		"creator_id": "other-uid", "donation": [{"ID": %d, "comment": "rewritten", "amount": 1}]}`, donation.ID)
+	recorder := serve(testCaller{UID: creator.ID}, http.MethodPut, "/piggy/:piggy_id", path, body, update)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	saved := getPiggy(db, fmt.Sprint(piggy.ID))
+	assert.Equal(t, "Beach", saved.Name)
+	assert.Equal(t, "Saving", saved.Description)
+	assert.Equal(t, int64(2000), saved.Goal)
+	assert.True(t, saved.Broken)
+	assert.Equal(t, int64(500), saved.CollectedAmount)
+	assert.Equal(t, int64(50), saved.BreakerRoyalty)
+	assert.Equal(t, creator.ID, saved.CreatorID)
+	kept := getDonation(db, fmt.Sprint(donation.ID))
+	assert.Equal(t, "Good luck", kept.Comment)
+	assert.Equal(t, int64(500), kept.Amount)
+
+	recorder = serve(testCaller{UID: "other-uid"}, http.MethodPut, "/piggy/:piggy_id", path, `{"name": "Mine"}`, update)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
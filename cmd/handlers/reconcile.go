@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+)
+
+// Kinds of drift between the database and the contract.
+const (
+	DriftMissingRow    = "missing_row"
+	DriftNotOnChain    = "not_on_chain"
+	DriftMetadata      = "metadata"
+	DriftBroken        = "broken"
+	DriftDonationCount = "donation_count"
+	DriftTotalSupply   = "total_supply"
+)
+
+// Drift is a difference between a value in the contract and in the database.
+type Drift struct {
+	Kind     string      `json:"kind"`
+	PiggyID  uint        `json:"piggy_id,omitempty"`
+	Field    string      `json:"field,omitempty"`
+	Chain    interface{} `json:"chain"`
+	Database interface{} `json:"database"`
+	Repaired bool        `json:"repaired"`
+}
+
+type ReconcileReport struct {
+	ChainPiggies    int     `json:"chain_piggies"`
+	DatabasePiggies int     `json:"database_piggies"`
+	Drifts          []Drift `json:"drifts"`
+}
+
+type piggyDonationCount struct {
+	PiggyID uint
+	Count   int
+}
+
+// Reconcile diffs the piggies and donations in the database with the contract.
+// With repair the piggy rows are rewritten from the contract, the donation counts are only reported
+// since the donations missing a row are restored by the event indexer.
+func Reconcile(db *gorm.DB, ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string, repair bool) {
+	state, err := blockchainservices.ReadChainState(ctx, flowconfig, profile)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+		return
+	}
+	piggies := []entities.Piggy{}
+	if err := db.Find(&piggies).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	counts := []piggyDonationCount{}
+	err = db.Model(&entities.Donation{}).Select("piggy_id, count(*) as count").
+		Where("nft_id <> 0").Group("piggy_id").Scan(&counts).Error
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	donationCounts := map[uint]int{}
+	minted := 0
+	for _, count := range counts {
+		donationCounts[count.PiggyID] = count.Count
+		minted += count.Count
+	}
+
+	report := ReconcileReport{
+		ChainPiggies:    len(state.Piggies),
+		DatabasePiggies: len(piggies),
+		Drifts:          diffChainState(state, piggies, donationCounts, minted),
+	}
+	if repair {
+		if err := repairPiggies(db, state, piggies, report.Drifts); err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, report)
+}
+
+func diffChainState(state *blockchainservices.ChainState, piggies []entities.Piggy, donationCounts map[uint]int, minted int) []Drift {
+	drifts := []Drift{}
+	rows := map[uint]entities.Piggy{}
+	for _, piggy := range piggies {
+		rows[piggy.ID] = piggy
+	}
+	onChain := map[uint]bool{}
+	for _, chainPiggy := range state.Piggies {
+		id := uint(chainPiggy.ID)
+		onChain[id] = true
+		row, found := rows[id]
+		if !found {
+			drifts = append(drifts, Drift{Kind: DriftMissingRow, PiggyID: id, Chain: chainPiggy.Metadata})
+			continue
+		}
+		for _, field := range []struct{ key, value string }{
+			{"Name", row.Name},
+			{"Description", row.Description},
+			{"Creator", row.UserAddress},
+		} {
+			if chainPiggy.Metadata[field.key] != field.value {
+				drifts = append(drifts, Drift{Kind: DriftMetadata, PiggyID: id, Field: field.key, Chain: chainPiggy.Metadata[field.key], Database: field.value})
+			}
+		}
+		if chainPiggy.Broken != row.Broken {
+			drifts = append(drifts, Drift{Kind: DriftBroken, PiggyID: id, Field: "broken", Chain: chainPiggy.Broken, Database: row.Broken})
+		} else if chainPiggy.Broken {
+			if int64(chainPiggy.CollectedAmount) != row.CollectedAmount {
+				drifts = append(drifts, Drift{Kind: DriftBroken, PiggyID: id, Field: "collected_amount", Chain: chainPiggy.CollectedAmount, Database: row.CollectedAmount})
+			}
+			if int64(chainPiggy.BreakerRoyalty) != row.BreakerRoyalty {
+				drifts = append(drifts, Drift{Kind: DriftBroken, PiggyID: id, Field: "breaker_royalty", Chain: chainPiggy.BreakerRoyalty, Database: row.BreakerRoyalty})
+			}
+		}
+		if int(chainPiggy.Donations) != donationCounts[id] {
+			drifts = append(drifts, Drift{Kind: DriftDonationCount, PiggyID: id, Chain: chainPiggy.Donations, Database: donationCounts[id]})
+		}
+	}
+	for _, piggy := range piggies {
+		if !onChain[piggy.ID] {
+			drifts = append(drifts, Drift{Kind: DriftNotOnChain, PiggyID: piggy.ID, Database: piggy.Name})
+		}
+	}
+	if state.TotalSupply != uint64(minted) {
+		drifts = append(drifts, Drift{Kind: DriftTotalSupply, Chain: state.TotalSupply, Database: minted})
+	}
+	sort.SliceStable(drifts, func(a, b int) bool {
+		return drifts[a].PiggyID < drifts[b].PiggyID
+	})
+	return drifts
+}
+
+// repairPiggies rewrites from the contract the piggy rows with missing, metadata or broken drift.
+func repairPiggies(db *gorm.DB, state *blockchainservices.ChainState, piggies []entities.Piggy, drifts []Drift) error {
+	rows := map[uint]entities.Piggy{}
+	for _, piggy := range piggies {
+		rows[piggy.ID] = piggy
+	}
+	chainPiggies := map[uint]blockchainservices.ChainPiggy{}
+	for _, chainPiggy := range state.Piggies {
+		chainPiggies[uint(chainPiggy.ID)] = chainPiggy
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		repaired := map[uint]bool{}
+		for i := range drifts {
+			drift := &drifts[i]
+			if drift.Kind != DriftMissingRow && drift.Kind != DriftMetadata && drift.Kind != DriftBroken {
+				continue
+			}
+			if !repaired[drift.PiggyID] {
+				row := rows[drift.PiggyID]
+				applyChainPiggy(&row, chainPiggies[drift.PiggyID])
+				if err := tx.Save(&row).Error; err != nil {
+					return err
+				}
+				repaired[drift.PiggyID] = true
+			}
+			drift.Repaired = true
+		}
+		return nil
+	})
+}
+
+func applyChainPiggy(row *entities.Piggy, chainPiggy blockchainservices.ChainPiggy) {
+	row.ID = uint(chainPiggy.ID)
+	row.Name = chainPiggy.Metadata["Name"]
+	row.Description = chainPiggy.Metadata["Description"]
+	row.UserAddress = chainPiggy.Metadata["Creator"]
+	row.Broken = chainPiggy.Broken
+	if chainPiggy.Broken {
+		row.CollectedAmount = int64(chainPiggy.CollectedAmount)
+		row.BreakerRoyalty = int64(chainPiggy.BreakerRoyalty)
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"testing"
+
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffChainState(t *testing.T) {
+	state := &blockchainservices.ChainState{
+		TotalSupply: 3,
+		Piggies: []blockchainservices.ChainPiggy{
+			{PiggyData: flowUtils.PiggyData{ID: 1, Metadata: map[string]string{"Name": "Trip", "Description": "Summer", "Creator": "36e55122ece3464c"}}, Donations: 2},
+			{PiggyData: flowUtils.PiggyData{ID: 2, Metadata: map[string]string{"Name": "Bike"}, Broken: true, CollectedAmount: 90, BreakerRoyalty: 10}},
+			{PiggyData: flowUtils.PiggyData{ID: 3, Metadata: map[string]string{"Name": "Car"}}, Donations: 1},
+		},
+	}
+	piggies := []entities.Piggy{
+		{Name: "Trip", Description: "Summer", UserAddress: "36e55122ece3464c"},
+		{Name: "Old bike", Broken: true, CollectedAmount: 90},
+		{Name: "Draft"},
+	}
+	piggies[0].ID, piggies[1].ID, piggies[2].ID = 1, 2, 4
+
+	drifts := diffChainState(state, piggies, map[uint]int{1: 1}, 1)
+
+	assert.Equal(t, []Drift{
+		{Kind: DriftTotalSupply, Chain: uint64(3), Database: 1},
+		{Kind: DriftDonationCount, PiggyID: 1, Chain: uint32(2), Database: 1},
+		{Kind: DriftMetadata, PiggyID: 2, Field: "Name", Chain: "Bike", Database: "Old bike"},
+		{Kind: DriftBroken, PiggyID: 2, Field: "breaker_royalty", Chain: uint64(10), Database: int64(0)},
+		{Kind: DriftMissingRow, PiggyID: 3, Chain: map[string]string{"Name": "Car"}},
+		{Kind: DriftNotOnChain, PiggyID: 4, Database: "Draft"},
+	}, drifts)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+
+	"firebase.google.com/go/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/manubidegain/piggy-api/firebase"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+
+	"github.com/jinzhu/gorm"
+)
+
+type RolesRequest struct {
+	Roles []string `json:"roles"`
+}
+
+type RolesResponse struct {
+	UserID string   `json:"user_id"`
+	Roles  []string `json:"roles"`
+}
+
+func GetUserRoles(db *gorm.DB, ctx *gin.Context, client *auth.Client) {
+	id := ctx.Param("user_id")
+	if getUserByToken(db, id) == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return
+	}
+	roles, err := firebase.GetUserRoles(ctx, client, id)
+	if err != nil {
+		respondFirebaseError(ctx, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, RolesResponse{UserID: id, Roles: roles})
+}
+
+// SetUserRoles replaces the roles granted to a user in its custom claims.
+func SetUserRoles(db *gorm.DB, ctx *gin.Context, client *auth.Client) {
+	id := ctx.Param("user_id")
+	request := RolesRequest{}
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	for _, role := range request.Roles {
+		if !middlewares.ValidRole(role) {
+			ctx.IndentedJSON(http.StatusBadRequest, "Unknown role "+role)
+			return
+		}
+	}
+	if getUserByToken(db, id) == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return
+	}
+	if err := firebase.SetUserRoles(ctx, client, id, request.Roles); err != nil {
+		respondFirebaseError(ctx, err)
+		return
+	}
+	roles := middlewares.RolesFromClaim(middlewares.RolesClaim(request.Roles))
+	ctx.IndentedJSON(http.StatusOK, RolesResponse{UserID: id, Roles: roles})
+}
+
+func respondFirebaseError(ctx *gin.Context, err error) {
+	if auth.IsUserNotFound(err) {
+		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return
+	}
+	ctx.IndentedJSON(http.StatusBadGateway, err.Error())
+}
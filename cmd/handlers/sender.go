@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
 
 	"firebase.google.com/go/auth"
 	"github.com/gin-gonic/gin"
@@ -17,31 +18,21 @@ import (
 
 const TWILIO_PHONE_NUMBER = "+19382531274"
 
+// EmailSignup sends the invited friend an email sign-in link back to the member login, carrying the invitation.
 func EmailSignup(client *auth.Client,
 	ctx *gin.Context,
 	config *configuration.Config,
-	user *entities.User,
-	senderName string,
-	userID string) {
-
-	url := fmt.Sprintf("%smember-login?email=%s&first_name=%s&last_name=%s&invited_by=%s&&user_id=%s",
-		config.Sender.CallbackURL,
-		url.QueryEscape(user.Email),
-		user.DisplayName,
-		user.DisplayName,
-		senderName,
-		userID,
-	)
+	invitation *entities.Invitation,
+	senderName string) error {
 
 	actionCodeSettings := &auth.ActionCodeSettings{
-		URL:             url,
+		URL:             invitationURL(config.Sender.CallbackURL, invitation, senderName),
 		HandleCodeInApp: false,
 	}
-	emailLink, err := client.EmailSignInLink(ctx, user.Email, actionCodeSettings)
+	emailLink, err := client.EmailSignInLink(ctx, invitation.Email, actionCodeSettings)
 	if err != nil {
-		fmt.Println("An error has ocurred creating the email link" + err.Error())
+		return fmt.Errorf("cannot create the email link: %w", err)
 	}
-	fmt.Println(emailLink)
 	from := mail.NewEmail("Piggy", "piggy@gmail.com")
 	apikey := os.Getenv("SENDGRID_API_KEY")
 	senderClient := sendgrid.NewSendClient(apikey)
@@ -50,7 +41,7 @@ func EmailSignup(client *auth.Client,
 
 	p := mail.NewPersonalization()
 	tos := []*mail.Email{
-		mail.NewEmail(user.DisplayName, user.Email),
+		mail.NewEmail(strings.TrimSpace(invitation.FirstName+" "+invitation.LastName), invitation.Email),
 	}
 	p.AddTos(tos...)
 	m.SetTemplateID("d-d2ae83297aac42f1921e101c0b89820d")
@@ -60,10 +51,25 @@ func EmailSignup(client *auth.Client,
 	m.AddPersonalizations(p)
 	response, err := senderClient.Send(m)
 	if err != nil {
-		fmt.Println("An error has ocurred sending the email" + err.Error())
+		return fmt.Errorf("cannot send the email: %w", err)
 	}
-	fmt.Println(response)
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("cannot send the email: sendgrid answered %d", response.StatusCode)
+	}
+	return nil
+}
 
+// invitationURL returns the member login URL of the invitation, every parameter escaped.
+func invitationURL(callbackURL string, invitation *entities.Invitation, senderName string) string {
+	query := url.Values{}
+	query.Set("email", invitation.Email)
+	query.Set("first_name", invitation.FirstName)
+	query.Set("last_name", invitation.LastName)
+	query.Set("invited_by", senderName)
+	query.Set("user_id", invitation.InviterID)
+	query.Set("invitation_id", fmt.Sprint(invitation.ID))
+	query.Set("piggy_id", fmt.Sprint(invitation.PiggyID))
+	return callbackURL + "member-login?" + query.Encode()
 }
 
 func PasswordResetEmail(client *auth.Client, ctx *gin.Context, config *configuration.Config, email string) error {
@@ -1 +1,192 @@
 package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/client"
+	"github.com/stripe/stripe-go/webhook"
+)
+
+// Donations are charged in cents, the same unit the contract uses for collected amounts.
+const donationCurrency = "usd"
+
+// Stripe webhook events that move a donation payment.
+const (
+	paymentIntentSucceeded = "payment_intent.succeeded"
+	paymentIntentFailed    = "payment_intent.payment_failed"
+	chargeRefunded         = "charge.refunded"
+)
+
+// CreatePaymentIntent creates the Stripe PaymentIntent that has to be confirmed before the donation is minted.
+func CreatePaymentIntent(stripeClient *client.API, charge utils.ChargeJSON, donation *entities.Donation) (*stripe.PaymentIntent, error) {
+	if charge.Amount <= 0 {
+		return nil, errors.New("donation amount must be greater than zero")
+	}
+
+	params := &stripe.PaymentIntentParams{
+		Amount:             stripe.Int64(charge.Amount),
+		Currency:           stripe.String(donationCurrency),
+		PaymentMethodTypes: stripe.StringSlice([]string{"card"}),
+		Description:        stripe.String(fmt.Sprintf("Donation to piggy %d", donation.PiggyID)),
+	}
+	if charge.ReceiptEmail != "" {
+		params.ReceiptEmail = stripe.String(charge.ReceiptEmail)
+	}
+	params.AddMetadata("piggy_id", fmt.Sprint(donation.PiggyID))
+	params.AddMetadata("sender_id", donation.SenderID)
+
+	return stripeClient.PaymentIntents.New(params)
+}
+
+// GetPaymentIntent retrieves the PaymentIntent related to a donation.
+func GetPaymentIntent(stripeClient *client.API, paymentIntentID string) (*stripe.PaymentIntent, error) {
+	if paymentIntentID == "" {
+		return nil, errors.New("donation has no related payment")
+	}
+	return stripeClient.PaymentIntents.Get(paymentIntentID, nil)
+}
+
+// PaymentConfirmed reports whether the PaymentIntent was charged for the expected amount, in the donation currency.
+func PaymentConfirmed(paymentIntent *stripe.PaymentIntent, amount int64) bool {
+	return paymentIntent.Status == stripe.PaymentIntentStatusSucceeded &&
+		paymentIntent.Currency == donationCurrency &&
+		paymentIntent.AmountReceived >= amount
+}
+
+// paymentUpdate returns the PaymentIntent referenced by a webhook event and the donation status it leads to.
+// ok is false for events that do not affect donations.
+func paymentUpdate(event stripe.Event) (paymentIntentID string, status entities.DonationStatus, ok bool) {
+	switch event.Type {
+	case paymentIntentSucceeded:
+		return event.GetObjectValue("id"), entities.DonationPaid, true
+	case paymentIntentFailed:
+		return event.GetObjectValue("id"), entities.DonationFailed, true
+	case chargeRefunded:
+		return event.GetObjectValue("payment_intent"), entities.DonationRefunded, true
+	}
+	return "", "", false
+}
+
+// outdatedPaymentEvent reports whether the donation is already where the event moves it,
+// or past it: a payment that succeeds or fails once the donation is paid changes nothing.
+func outdatedPaymentEvent(donation *entities.Donation, status entities.DonationStatus) bool {
+	if donation.Status == status {
+		return true
+	}
+	return status != entities.DonationRefunded && donation.PaidAt != nil
+}
+
+// StripeWebhook applies Stripe payment events to the related donation, once per event.
+// An empty secret is refused, the signature of any payload could be computed with it.
+func StripeWebhook(db *gorm.DB, ctx *gin.Context, webhookSecret string, queue *jobs.Queue) {
+	if webhookSecret == "" {
+		ctx.IndentedJSON(http.StatusServiceUnavailable, "stripe webhook is not configured")
+		return
+	}
+	payload, err := ctx.GetRawData()
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+	event, err := webhook.ConstructEvent(payload, ctx.GetHeader("Stripe-Signature"), webhookSecret)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+	paymentIntentID, status, ok := paymentUpdate(event)
+	if !ok {
+		ctx.IndentedJSON(http.StatusOK, "event ignored")
+		return
+	}
+
+	tx := db.Begin()
+	if !tx.First(&entities.StripeEvent{}, "id = ?", event.ID).RecordNotFound() {
+		tx.Rollback()
+		ctx.IndentedJSON(http.StatusOK, "event already processed")
+		return
+	}
+	if err := tx.Create(&entities.StripeEvent{ID: event.ID, Type: event.Type}).Error; err != nil {
+		tx.Rollback()
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	donation := entities.Donation{}
+	if tx.First(&donation, "payment_related_transaction = ?", paymentIntentID).RecordNotFound() {
+		if err := tx.Commit().Error; err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+		ctx.IndentedJSON(http.StatusOK, "no donation for payment")
+		return
+	}
+	reason := ""
+	switch status {
+	case entities.DonationFailed:
+		reason = event.GetObjectValue("last_payment_error", "message")
+	case entities.DonationPaid:
+		// a payment for less than the donation, or in another currency, does not pay it
+		paymentIntent := stripe.PaymentIntent{}
+		if err := json.Unmarshal(event.Data.Raw, &paymentIntent); err != nil {
+			tx.Rollback()
+			ctx.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+		if !PaymentConfirmed(&paymentIntent, donation.Amount) {
+			status = entities.DonationFailed
+			reason = fmt.Sprintf("received %d %s for a donation of %d %s",
+				paymentIntent.AmountReceived, paymentIntent.Currency, donation.Amount, donationCurrency)
+		}
+	}
+	if outdatedPaymentEvent(&donation, status) {
+		if err := tx.Commit().Error; err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+		ctx.IndentedJSON(http.StatusOK, fmt.Sprintf("donation is already %s", donation.Status))
+		return
+	}
+	if !donation.CanTransitionTo(status) {
+		// not recorded, Stripe sends the event again until the donation can take it, as a refund while minting
+		tx.Rollback()
+		ctx.IndentedJSON(http.StatusConflict, fmt.Sprintf("donation is %s, it cannot be %s yet", donation.Status, status))
+		return
+	}
+	if status == entities.DonationFailed {
+		err = donation.Fail(reason)
+	} else {
+		err = donation.TransitionTo(status)
+	}
+	if err != nil {
+		tx.Rollback()
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := tx.Save(&donation).Error; err != nil {
+		tx.Rollback()
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := tx.Commit().Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// The event is already applied, a mint that cannot be queued is retried through the confirm endpoint.
+	// The job belongs to the donor, as the one ConfirmDonation queues, so the donor can follow it.
+	if status == entities.DonationPaid {
+		if _, err := queue.Enqueue(MintDonationJob, donation.SenderUserID, MintDonationPayload{DonationID: donation.ID}); err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	ctx.IndentedJSON(http.StatusOK, donation)
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/client"
+	"github.com/stripe/stripe-go/webhook"
+)
+
+// newStripeStub serves the payment intent endpoints used by the donation flow.
+func newStripeStub(t *testing.T, status stripe.PaymentIntentStatus) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		intent := stripe.PaymentIntent{ID: "pi_test", ClientSecret: "pi_test_secret", Currency: donationCurrency}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/payment_intents":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "1500", r.Form.Get("amount"))
+			assert.Equal(t, "7", r.Form.Get("metadata[piggy_id]"))
+			intent.Status = stripe.PaymentIntentStatusRequiresPaymentMethod
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/payment_intents/pi_test":
+			intent.Status = status
+			intent.Amount = 1500
+			if status == stripe.PaymentIntentStatusSucceeded {
+				intent.AmountReceived = 1500
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(intent)
+	}))
+}
+
+func newStubbedStripeClient(url string) *client.API {
+	sc := &client.API{}
+	sc.Init("sk_test_stub", utils.StripeBackends(url))
+	return sc
+}
+
+func TestCreatePaymentIntent(t *testing.T) {
+	stub := newStripeStub(t, stripe.PaymentIntentStatusSucceeded)
+	defer stub.Close()
+	sc := newStubbedStripeClient(stub.URL)
+
+	donation := &entities.Donation{PiggyID: 7, SenderID: "0x01", Amount: 1500}
+	intent, err := CreatePaymentIntent(sc, utils.ChargeJSON{Amount: donation.Amount}, donation)
+	require.NoError(t, err)
+	assert.Equal(t, "pi_test", intent.ID)
+	assert.Equal(t, "pi_test_secret", intent.ClientSecret)
+
+	_, err = CreatePaymentIntent(sc, utils.ChargeJSON{Amount: 0}, donation)
+	assert.Error(t, err)
+}
+
+func TestPaymentConfirmed(t *testing.T) {
+	paid := newStripeStub(t, stripe.PaymentIntentStatusSucceeded)
+	defer paid.Close()
+	intent, err := GetPaymentIntent(newStubbedStripeClient(paid.URL), "pi_test")
+	require.NoError(t, err)
+	assert.True(t, PaymentConfirmed(intent, 1500))
+	assert.False(t, PaymentConfirmed(intent, 2000))
+
+	pending := newStripeStub(t, stripe.PaymentIntentStatusRequiresAction)
+	defer pending.Close()
+	intent, err = GetPaymentIntent(newStubbedStripeClient(pending.URL), "pi_test")
+	require.NoError(t, err)
+	assert.False(t, PaymentConfirmed(intent, 1500))
+
+	_, err = GetPaymentIntent(newStubbedStripeClient(pending.URL), "")
+	assert.Error(t, err)
+}
+
+func signedEvent(t *testing.T, payload string, secret string) stripe.Event {
+	now := time.Now()
+	header := fmt.Sprintf("t=%d,v1=%x", now.Unix(), webhook.ComputeSignature(now, []byte(payload), secret))
+	event, err := webhook.ConstructEvent([]byte(payload), header, secret)
+	require.NoError(t, err)
+	return event
+}
+
+func TestPaymentUpdate(t *testing.T) {
+	secret := "whsec_test"
+
+	event := signedEvent(t, `{"id":"evt_1","type":"payment_intent.succeeded","data":{"object":{"id":"pi_test"}}}`, secret)
+	paymentIntentID, status, ok := paymentUpdate(event)
+	assert.True(t, ok)
+	assert.Equal(t, "pi_test", paymentIntentID)
+	assert.Equal(t, entities.DonationPaid, status)
+
+	event = signedEvent(t, `{"id":"evt_2","type":"charge.refunded","data":{"object":{"id":"ch_test","payment_intent":"pi_test"}}}`, secret)
+	paymentIntentID, status, ok = paymentUpdate(event)
+	assert.True(t, ok)
+	assert.Equal(t, "pi_test", paymentIntentID)
+	assert.Equal(t, entities.DonationRefunded, status)
+
+	event = signedEvent(t, `{"id":"evt_3","type":"customer.created","data":{"object":{"id":"cus_test"}}}`, secret)
+	_, _, ok = paymentUpdate(event)
+	assert.False(t, ok)
+
+	_, err := webhook.ConstructEvent([]byte(`{"id":"evt_4"}`), "t=1,v1=00", secret)
+	assert.Error(t, err)
+}
+
+func TestStripeWebhookWithoutSecret(t *testing.T) {
+	payload := `{"id":"evt_1","type":"payment_intent.succeeded","data":{"object":{"id":"pi_test"}}}`
+	now := time.Now()
+	// with an empty key anyone computes a valid signature
+	header := fmt.Sprintf("t=%d,v1=%x", now.Unix(), webhook.ComputeSignature(now, []byte(payload), ""))
+	webhookHandler := func(ctx *gin.Context) {
+		ctx.Request.Header.Set("Stripe-Signature", header)
+		StripeWebhook(nil, ctx, "", nil)
+	}
+
+	recorder := serve(testCaller{}, http.MethodPost, "/webhooks/stripe", "/webhooks/stripe", payload, webhookHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
+
+// postStripeEvent sends the webhook a payload signed with secret.
+func postStripeEvent(db *gorm.DB, queue *jobs.Queue, secret string, payload string) *httptest.ResponseRecorder {
+	now := time.Now()
+	header := fmt.Sprintf("t=%d,v1=%x", now.Unix(), webhook.ComputeSignature(now, []byte(payload), secret))
+	webhookHandler := func(ctx *gin.Context) {
+		ctx.Request.Header.Set("Stripe-Signature", header)
+		StripeWebhook(db, ctx, secret, queue)
+	}
+	return serve(testCaller{}, http.MethodPost, "/webhooks/stripe", "/webhooks/stripe", payload, webhookHandler)
+}
+
+func TestStripeWebhookRetriesRefusedTransitions(t *testing.T) {
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	secret := "whsec_test"
+	paidAt := time.Now().UTC()
+	donation := entities.Donation{SenderUserID: "donor-uid", Amount: 1500, PaymentRelatedTransaction: "pi_test", Status: entities.DonationMinting, PaidAt: &paidAt}
+	require.NoError(t, db.Create(&donation).Error)
+	refund := `{"id":"evt_refund","type":"charge.refunded","data":{"object":{"id":"ch_test","payment_intent":"pi_test"}}}`
+
+	// a refund while minting is neither applied nor recorded
+	assert.Equal(t, http.StatusConflict, postStripeEvent(db, queue, secret, refund).Code)
+	assert.True(t, db.First(&entities.StripeEvent{}, "id = ?", "evt_refund").RecordNotFound())
+	assert.Equal(t, entities.DonationMinting, getDonation(db, fmt.Sprint(donation.ID)).Status)
+
+	// the late success of the payment is acknowledged without changes
+	succeeded := `{"id":"evt_paid","type":"payment_intent.succeeded","data":{"object":{"id":"pi_test","status":"succeeded","amount_received":1500,"currency":"usd"}}}`
+	assert.Equal(t, http.StatusOK, postStripeEvent(db, queue, secret, succeeded).Code)
+	assert.Equal(t, entities.DonationMinting, getDonation(db, fmt.Sprint(donation.ID)).Status)
+
+	// once minted, the retried refund applies
+	require.NoError(t, db.Model(&donation).Update("status", entities.DonationMinted).Error)
+	assert.Equal(t, http.StatusOK, postStripeEvent(db, queue, secret, refund).Code)
+	assert.Equal(t, entities.DonationRefunded, getDonation(db, fmt.Sprint(donation.ID)).Status)
+	assert.False(t, db.First(&entities.StripeEvent{}, "id = ?", "evt_refund").RecordNotFound())
+	assert.Equal(t, http.StatusOK, postStripeEvent(db, queue, secret, refund).Code)
+}
+
+func TestStripeWebhookQueuesTheMintForTheDonor(t *testing.T) {
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	donation := entities.Donation{SenderUserID: "donor-uid", SenderID: "01cf0e2f2f715450", Amount: 1500, PaymentRelatedTransaction: "pi_test"}
+	require.NoError(t, db.Create(&donation).Error)
+
+	succeeded := `{"id":"evt_paid","type":"payment_intent.succeeded","data":{"object":{"id":"pi_test","status":"succeeded","amount_received":1500,"currency":"usd"}}}`
+	require.Equal(t, http.StatusOK, postStripeEvent(db, queue, "whsec_test", succeeded).Code)
+	assert.Equal(t, entities.DonationPaid, getDonation(db, fmt.Sprint(donation.ID)).Status)
+	job := entities.Job{}
+	require.NoError(t, db.Where("type = ?", MintDonationJob).First(&job).Error)
+
+	// the donor follows the job
+	recorder := serve(testCaller{UID: "donor-uid"}, http.MethodGet, "/jobs/:job_id", "/jobs/"+fmt.Sprint(job.ID), "", func(ctx *gin.Context) { GetJob(db, ctx) })
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestStripeWebhookChecksTheAmountReceived(t *testing.T) {
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	short := entities.Donation{SenderUserID: "donor-uid", Amount: 1500, PaymentRelatedTransaction: "pi_short"}
+	require.NoError(t, db.Create(&short).Error)
+	foreign := entities.Donation{SenderUserID: "donor-uid", Amount: 1500, PaymentRelatedTransaction: "pi_foreign"}
+	require.NoError(t, db.Create(&foreign).Error)
+
+	payload := `{"id":"evt_short","type":"payment_intent.succeeded","data":{"object":{"id":"pi_short","status":"succeeded","amount_received":100,"currency":"usd"}}}`
+	assert.Equal(t, http.StatusOK, postStripeEvent(db, queue, "whsec_test", payload).Code)
+	donation := getDonation(db, fmt.Sprint(short.ID))
+	assert.Equal(t, entities.DonationFailed, donation.Status)
+	assert.Equal(t, "received 100 usd for a donation of 1500 usd", donation.FailureReason)
+
+	payload = `{"id":"evt_foreign","type":"payment_intent.succeeded","data":{"object":{"id":"pi_foreign","status":"succeeded","amount_received":1500,"currency":"eur"}}}`
+	assert.Equal(t, http.StatusOK, postStripeEvent(db, queue, "whsec_test", payload).Code)
+	assert.Equal(t, entities.DonationFailed, getDonation(db, fmt.Sprint(foreign.ID)).Status)
+
+	// nothing to mint
+	assert.True(t, db.Where("type = ?", MintDonationJob).First(&entities.Job{}).RecordNotFound())
+}
@@ -2,15 +2,15 @@ package handlers
 
 import (
 	"fmt"
-	"log"
 	"math/rand"
 	"net/http"
+	"strings"
 
 	"firebase.google.com/go/auth"
 	"github.com/gin-gonic/gin"
 	"github.com/manubidegain/piggy-api/cmd/api/configuration"
-	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
 	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
 
 	"github.com/jinzhu/gorm"
 )
@@ -32,30 +32,69 @@ func GetAllUsers(db *gorm.DB, ctx *gin.Context) {
 
 }
 
-func UserSignup(db *gorm.DB, ctx *gin.Context, profile string, flowConfig *configuration.FlowConfig, log *log.Logger, projectConfig *configuration.ProjectConfig) {
-	model := entities.User{}
+// UserSignup stores the calling user and queues the creation of its custodial account.
+// Signing up again returns the user once it has an account, or the account job still pending,
+// so a retried signup never creates a second account.
+func UserSignup(db *gorm.DB, ctx *gin.Context, queue *jobs.Queue) {
 	user := entities.User{}
 	if err := ctx.BindJSON(&user); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
 	id := ctx.GetString("UUID")
-	user.ID = id
-	flowAddress, err := blockchainservices.CreateAccount(ctx, profile, flowConfig, log, projectConfig)
-	if err != nil {
+	if id == "" {
+		ctx.IndentedJSON(http.StatusUnauthorized, "signup needs an authenticated user")
+		return
+	}
+	// the email is the verified one of the token, never the one claimed in the body
+	email := ctx.GetString("userEmail")
+	if user.Email != "" && !strings.EqualFold(user.Email, email) {
+		ctx.IndentedJSON(http.StatusForbidden, "signup email differs from the one of the signed in user")
+		return
+	}
+	status := http.StatusOK
+	model := getUser(db, id)
+	if model == nil {
+		status = http.StatusCreated
+		model = &entities.User{
+			ID:             id,
+			Email:          email,
+			DisplayName:    user.DisplayName,
+			StreetAddress:  user.StreetAddress,
+			ExternalWallet: user.ExternalWallet,
+		}
+		if err := db.Create(model).Error; err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	// the friends invited to this email have signed up
+	if err := acceptInvitations(db, model, email); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	user.FlowAddress = flowAddress
-	if err := db.FirstOrCreate(&model, user).Error; err != nil {
+	// users bringing their own wallet link it afterwards through a signed challenge
+	if model.ExternalWallet || model.FlowAddress != "" {
+		ctx.IndentedJSON(status, model)
+		return
+	}
+	pending := entities.Job{}
+	err := db.Where("type = ? AND user_id = ? AND status IN (?)", CreateAccountJob, id, []entities.JobStatus{entities.JobQueued, entities.JobRunning}).
+		Order("id DESC").First(&pending).Error
+	if err == nil {
+		ctx.IndentedJSON(http.StatusAccepted, newJobResponse(&pending))
+		return
+	}
+	if !gorm.IsRecordNotFoundError(err) {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	if model.Status {
-		ctx.IndentedJSON(http.StatusUnprocessableEntity, "user already exist")
+	job, err := queue.Enqueue(CreateAccountJob, id, CreateAccountPayload{UserID: id, Email: email})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	ctx.IndentedJSON(http.StatusCreated, model)
+	ctx.IndentedJSON(http.StatusAccepted, newJobResponse(job))
 }
 
 func GetUser(db *gorm.DB, ctx *gin.Context) {
@@ -65,10 +104,19 @@ func GetUser(db *gorm.DB, ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusNotFound, "User not found")
 		return
 	}
+	if !authorizeOwner(db, ctx, user.IsSelf) {
+		return
+	}
 	ctx.IndentedJSON(http.StatusOK, user)
 }
 
-// TODO
+// UpdateUserRequest holds the profile fields a user edits. The flow address and the wallet kind
+// are only set by the account creation and the external wallet proof, the status by the admins.
+type UpdateUserRequest struct {
+	DisplayName   string `json:"display_name"`
+	StreetAddress string `json:"street_address"`
+}
+
 func UpdateUser(db *gorm.DB, ctx *gin.Context) {
 	id := ctx.Param("user_id")
 	user := getUser(db, id)
@@ -76,13 +124,21 @@ func UpdateUser(db *gorm.DB, ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusNotFound, "User not found")
 		return
 	}
+	if !authorizeOwner(db, ctx, user.IsSelf) {
+		return
+	}
 
-	if err := ctx.BindJSON(&user); err != nil {
+	request := UpdateUserRequest{}
+	if err := ctx.BindJSON(&request); err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
+	user.DisplayName = request.DisplayName
+	user.StreetAddress = request.StreetAddress
 
-	if err := db.Save(&user).Error; err != nil {
+	// keyed on the user ID, the email of phone users is empty
+	updates := map[string]interface{}{"display_name": request.DisplayName, "street_address": request.StreetAddress}
+	if err := db.Model(&entities.User{}).Where("id = ?", user.ID).Updates(updates).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -94,9 +150,11 @@ func DeleteUser(db *gorm.DB, ctx *gin.Context) {
 	user := getUser(db, id)
 	if user == nil {
 		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return
 	}
 	if err := db.Delete(&user).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 	ctx.IndentedJSON(http.StatusOK, id)
 }
@@ -106,10 +164,12 @@ func DisableUser(db *gorm.DB, ctx *gin.Context) {
 	user := getUser(db, id)
 	if user == nil {
 		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return
 	}
 	user.Disable()
 	if err := db.Save(&user).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 	ctx.IndentedJSON(http.StatusOK, id)
 }
@@ -119,10 +179,12 @@ func EnableUser(db *gorm.DB, ctx *gin.Context) {
 	user := getUser(db, id)
 	if user == nil {
 		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return
 	}
 	user.Enable()
 	if err := db.Save(&user).Error; err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 	ctx.IndentedJSON(http.StatusOK, id)
 }
@@ -147,7 +209,7 @@ func ForgotPassword(db *gorm.DB, client *auth.Client, ctx *gin.Context, config *
 
 func getUser(db *gorm.DB, id string) *entities.User {
 	user := entities.User{}
-	if err := db.First(&user, id).Error; err != nil {
+	if err := db.Where("id = ?", id).First(&user).Error; err != nil {
 		return nil
 	}
 	return &user
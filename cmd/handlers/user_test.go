@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUserKeepsWalletFields(t *testing.T) {
+	db := newTestDB(t)
+	victim := entities.User{ID: "victim-uid", Email: "victim@piggy.test", FlowAddress: "01cf0e2f2f715450"}
+	caller := entities.User{ID: "caller-uid", Email: "caller@piggy.test", FlowAddress: "179b6b1cb6755e31"}
+	require.NoError(t, db.Create(&victim).Error)
+	require.NoError(t, db.Create(&caller).Error)
+	update := func(ctx *gin.Context) { UpdateUser(db, ctx) }
+	body := `{"display_name": "Caller", "flow_address": "01cf0e2f2f715450", "external_wallet": true, "flow_address_verified": true, "status": true}`
+
+	recorder := serve(testCaller{UID: caller.ID}, http.MethodPut, "/users/:user_id", "/users/caller-uid", body, update)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	saved := getUser(db, caller.ID)
+	assert.Equal(t, "Caller", saved.DisplayName)
+	assert.Equal(t, "179b6b1cb6755e31", saved.FlowAddress)
+	assert.False(t, saved.ExternalWallet)
+	assert.False(t, saved.FlowAddressVerified)
+	assert.False(t, saved.Status)
+
+	recorder = serve(testCaller{UID: caller.ID}, http.MethodPut, "/users/:user_id", "/users/victim-uid", body, update)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Empty(t, getUser(db, victim.ID).DisplayName)
+
+	recorder = serve(testCaller{UID: "admin-uid", Roles: []string{middlewares.AdminRole}}, http.MethodPut, "/users/:user_id", "/users/victim-uid", `{"display_name": "Victim"}`, update)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "Victim", getUser(db, victim.ID).DisplayName)
+
+	// phone users have no email
+	phone := entities.User{ID: "phone-uid"}
+	require.NoError(t, db.Create(&phone).Error)
+	recorder = serve(testCaller{UID: phone.ID}, http.MethodPut, "/users/:user_id", "/users/phone-uid", `{"display_name": "Phone"}`, update)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "Phone", getUser(db, phone.ID).DisplayName)
+	assert.Equal(t, "Victim", getUser(db, victim.ID).DisplayName)
+}
+
+func TestGetUserSelfOrAdmin(t *testing.T) {
+	db := newTestDB(t)
+	user := entities.User{ID: "user-uid", Email: "user@piggy.test"}
+	require.NoError(t, db.Create(&user).Error)
+	get := func(ctx *gin.Context) { GetUser(db, ctx) }
+
+	assert.Equal(t, http.StatusOK, serve(testCaller{UID: user.ID}, http.MethodGet, "/users/:user_id", "/users/user-uid", "", get).Code)
+	assert.Equal(t, http.StatusForbidden, serve(testCaller{UID: "other-uid"}, http.MethodGet, "/users/:user_id", "/users/user-uid", "", get).Code)
+	admin := testCaller{UID: "admin-uid", Roles: []string{middlewares.AdminRole}}
+	assert.Equal(t, http.StatusOK, serve(admin, http.MethodGet, "/users/:user_id", "/users/user-uid", "", get).Code)
+	assert.Equal(t, http.StatusNotFound, serve(admin, http.MethodGet, "/users/:user_id", "/users/missing-uid", "", get).Code)
+}
+
+func TestUserSignupRetries(t *testing.T) {
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	// the workers are not started, the account job stays queued
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	signup := func(ctx *gin.Context) { UserSignup(db, ctx, queue) }
+	caller := testCaller{UID: "new-uid", Email: "new@piggy.test"}
+	body := `{"email": "new@piggy.test", "display_name": "New", "flow_address": "01cf0e2f2f715450"}`
+
+	first := serve(caller, http.MethodPost, "/users", "/users", body, signup)
+	require.Equal(t, http.StatusAccepted, first.Code)
+	second := serve(caller, http.MethodPost, "/users", "/users", body, signup)
+	require.Equal(t, http.StatusAccepted, second.Code)
+	firstJob, secondJob := JobResponse{}, JobResponse{}
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &firstJob))
+	require.NoError(t, json.Unmarshal(second.Body.Bytes(), &secondJob))
+	assert.Equal(t, firstJob.ID, secondJob.ID)
+	count := 0
+	require.NoError(t, db.Model(&entities.Job{}).Where("type = ?", CreateAccountJob).Count(&count).Error)
+	assert.Equal(t, 1, count)
+	assert.Empty(t, getUser(db, caller.UID).FlowAddress)
+
+	// once the account exists signing up again returns the user
+	require.NoError(t, db.Model(&entities.User{}).Where("id = ?", caller.UID).Update("flow_address", "179b6b1cb6755e31").Error)
+	require.NoError(t, db.Model(&entities.Job{}).Where("id = ?", firstJob.ID).Update("status", entities.JobSucceeded).Error)
+	again := serve(caller, http.MethodPost, "/users", "/users", body, signup)
+	require.Equal(t, http.StatusOK, again.Code)
+	user := entities.User{}
+	require.NoError(t, json.Unmarshal(again.Body.Bytes(), &user))
+	assert.Equal(t, "179b6b1cb6755e31", user.FlowAddress)
+	require.NoError(t, db.Model(&entities.Job{}).Where("type = ?", CreateAccountJob).Count(&count).Error)
+	assert.Equal(t, 1, count)
+}
+
+func TestUserSignupVerifiedEmail(t *testing.T) {
+	db := newTestDB(t)
+	logger := log.New(io.Discard, "", 0)
+	queue := jobs.NewQueue(db, 1, logger)
+	RegisterJobHandlers(queue, db, &configuration.FlowConfig{}, utils.Development, logger, &configuration.ProjectConfig{})
+	signup := func(ctx *gin.Context) { UserSignup(db, ctx, queue) }
+	expires := time.Now().Add(time.Hour)
+	for _, email := range []string{"friend@piggy.test", "victim@piggy.test"} {
+		invitation := entities.Invitation{InviterID: "inviter", Email: email, Status: entities.InvitationPending, ExpiresAt: expires}
+		require.NoError(t, db.Create(&invitation).Error)
+	}
+	caller := testCaller{UID: "friend-uid", Email: "friend@piggy.test"}
+
+	recorder := serve(caller, http.MethodPost, "/users", "/users", `{"email": "victim@piggy.test"}`, signup)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Nil(t, getUser(db, caller.UID))
+
+	recorder = serve(caller, http.MethodPost, "/users", "/users", `{"display_name": "Friend"}`, signup)
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, caller.Email, getUser(db, caller.UID).Email)
+	accepted := []entities.Invitation{}
+	require.NoError(t, db.Where("status = ?", entities.InvitationAccepted).Find(&accepted).Error)
+	require.Len(t, accepted, 1)
+	assert.Equal(t, caller.Email, accepted[0].Email)
+	assert.Equal(t, caller.UID, accepted[0].AcceptedBy)
+}
+
+func TestUserAdministrationOfUnknownUsers(t *testing.T) {
+	db := newTestDB(t)
+	admin := testCaller{UID: "admin-uid", Roles: []string{middlewares.AdminRole}}
+	handlers := map[string]func(*gorm.DB, *gin.Context){"delete": DeleteUser, "disable": DisableUser, "enable": EnableUser}
+	for name, handler := range handlers {
+		handler := handler
+		recorder := serve(admin, http.MethodPost, "/users/:user_id", "/users/nobody-uid", "", func(ctx *gin.Context) { handler(db, ctx) })
+		assert.Equal(t, http.StatusNotFound, recorder.Code, name)
+		assert.Equal(t, `"User not found"`, recorder.Body.String(), name)
+	}
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/manubidegain/piggy-api/cmd/jobs"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// How long an external wallet has to sign a challenge.
+const walletChallengeTTL = 5 * time.Minute
+
+type WalletPayload struct {
+	Address string `json:"address"`
+}
+
+type TransferDonationPayload struct {
+	Address   string `json:"address"`
+	NftID     uint64 `json:"nft_id"`
+	Recipient string `json:"recipient"`
+}
+
+type WalletChallengeRequest struct {
+	Address string `json:"address"`
+}
+
+type WalletChallengeResponse struct {
+	Address   string    `json:"address"`
+	AppID     string    `json:"app_id"`
+	Nonce     string    `json:"nonce"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type LinkWalletRequest struct {
+	Address    string                                `json:"address"`
+	Nonce      string                                `json:"nonce"`
+	Signatures []blockchainservices.AccountSignature `json:"signatures"`
+}
+
+// SetupWallet runs the account setup again for the custodial account of the calling user.
+func SetupWallet(db *gorm.DB, ctx *gin.Context, queue *jobs.Queue) {
+	user, ok := custodialUser(db, ctx)
+	if !ok {
+		return
+	}
+	job, err := queue.Enqueue(SetupWalletJob, user.ID, WalletPayload{Address: user.FlowAddress})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusAccepted, newJobResponse(job))
+}
+
+// CreateWalletChallenge returns a nonce the calling user signs with the external wallet at the requested address.
+// The signed message is the account proof of the nonce, the same one FCL wallets sign.
+func CreateWalletChallenge(db *gorm.DB, ctx *gin.Context) {
+	request := WalletChallengeRequest{}
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !isFlowAddress(request.Address) {
+		ctx.IndentedJSON(http.StatusBadRequest, "address is not a flow address")
+		return
+	}
+	user, ok := callingUser(db, ctx)
+	if !ok {
+		return
+	}
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	challenge := entities.WalletChallenge{
+		UserID:    user.ID,
+		Address:   flow.HexToAddress(request.Address).Hex(),
+		Nonce:     hex.EncodeToString(nonce),
+		ExpiresAt: time.Now().UTC().Add(walletChallengeTTL),
+	}
+	if err := db.Create(&challenge).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusCreated, WalletChallengeResponse{
+		Address:   challenge.Address,
+		AppID:     blockchainservices.WalletProofAppID,
+		Nonce:     challenge.Nonce,
+		ExpiresAt: challenge.ExpiresAt,
+	})
+}
+
+// LinkExternalWallet stores the address of a signed challenge as the flow account of the calling user.
+func LinkExternalWallet(db *gorm.DB, ctx *gin.Context, flowconfig *configuration.FlowConfig, profile string) {
+	request := LinkWalletRequest{}
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !isFlowAddress(request.Address) {
+		ctx.IndentedJSON(http.StatusBadRequest, "address is not a flow address")
+		return
+	}
+	address := flow.HexToAddress(request.Address).Hex()
+	user, ok := callingUser(db, ctx)
+	if !ok {
+		return
+	}
+	if user.FlowAddress != "" && !user.ExternalWallet {
+		ctx.IndentedJSON(http.StatusConflict, "user already has a custodial account")
+		return
+	}
+
+	challenge := entities.WalletChallenge{}
+	if err := db.Where("user_id = ? AND nonce = ?", user.ID, request.Nonce).First(&challenge).Error; err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, "Challenge not found")
+		return
+	}
+	now := time.Now().UTC()
+	if !challenge.Usable(now) {
+		ctx.IndentedJSON(http.StatusGone, "challenge expired")
+		return
+	}
+	if challenge.Address != address {
+		ctx.IndentedJSON(http.StatusBadRequest, "challenge was issued for another address")
+		return
+	}
+	// answering the challenge consumes it, so a leaked proof cannot be replayed
+	claim := db.Model(&entities.WalletChallenge{}).Where("id = ? AND used_at IS NULL", challenge.ID).Update("used_at", now)
+	if claim.Error != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, claim.Error.Error())
+		return
+	}
+	if claim.RowsAffected == 0 {
+		ctx.IndentedJSON(http.StatusGone, "challenge expired")
+		return
+	}
+
+	owner := entities.User{}
+	if err := db.Where("flow_address = ? AND id <> ?", address, user.ID).First(&owner).Error; err == nil {
+		ctx.IndentedJSON(http.StatusConflict, "address is linked to another user")
+		return
+	}
+
+	err := blockchainservices.VerifyExternalWallet(ctx, address, challenge.Nonce, request.Signatures, flowconfig, profile)
+	switch {
+	case errors.Is(err, blockchainservices.ErrInvalidWalletProof):
+		ctx.IndentedJSON(http.StatusForbidden, err.Error())
+		return
+	case errors.Is(err, blockchainservices.ErrNoDonationCollection):
+		ctx.IndentedJSON(http.StatusUnprocessableEntity, err.Error())
+		return
+	case err != nil:
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	user.FlowAddress = address
+	user.ExternalWallet = true
+	user.FlowAddressVerified = true
+	updates := map[string]interface{}{"flow_address": address, "external_wallet": true, "flow_address_verified": true}
+	// keyed on the user ID, the email of phone users is empty
+	if err := db.Model(&entities.User{}).Where("id = ?", user.ID).Updates(updates).Error; err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, user)
+}
+
+// callingUser returns the user of the request token, responding when there is none.
+func callingUser(db *gorm.DB, ctx *gin.Context) (*entities.User, bool) {
+	id := ctx.GetString("UUID")
+	var user *entities.User
+	if id != "" {
+		user = getUserByToken(db, id)
+	}
+	if user == nil {
+		ctx.IndentedJSON(http.StatusNotFound, "User not found")
+		return nil, false
+	}
+	return user, true
+}
+
+// custodialUser returns the calling user when the api holds the key of its account, responding otherwise.
+func custodialUser(db *gorm.DB, ctx *gin.Context) (*entities.User, bool) {
+	user, ok := callingUser(db, ctx)
+	if !ok {
+		return nil, false
+	}
+	if user.ExternalWallet {
+		ctx.IndentedJSON(http.StatusConflict, "user signs with an external wallet")
+		return nil, false
+	}
+	if user.FlowAddress == "" {
+		ctx.IndentedJSON(http.StatusConflict, "user account is not created yet")
+		return nil, false
+	}
+	return user, true
+}
+
+func isFlowAddress(address string) bool {
+	address = strings.TrimPrefix(address, "0x")
+	decoded, err := hex.DecodeString(address)
+	return err == nil && len(decoded) == 8
+}
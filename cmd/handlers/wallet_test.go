@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFlowAddress(t *testing.T) {
+	assert.True(t, isFlowAddress("36e55122ece3464c"))
+	assert.True(t, isFlowAddress("0x36e55122ece3464c"))
+	assert.False(t, isFlowAddress(""))
+	assert.False(t, isFlowAddress("0x36e5"))
+	assert.False(t, isFlowAddress("not-an-address!!"))
+}
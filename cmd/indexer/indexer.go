@@ -0,0 +1,295 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+const (
+	checkpointName = "piggy_banks"
+	pollInterval   = 10 * time.Second
+	// Access nodes refuse event queries spanning more than 250 blocks.
+	maxRange = 200
+	// Blocks left behind the sealed head, so the api saves the mints it sent before they are indexed.
+	confirmations = 20
+)
+
+// Contract events the indexer applies.
+const (
+	PiggyCreated      = "PiggyCreated"
+	DonationMinted    = "DonationMinted"
+	SetBroken         = "SetBroken"
+	Withdraw          = "Withdraw"
+	Deposit           = "Deposit"
+	DonationDestroyed = "DonationDestroyed"
+)
+
+var eventNames = []string{PiggyCreated, DonationMinted, SetBroken, Withdraw, Deposit, DonationDestroyed}
+
+// Indexer copies the PiggyBanks contract events into the database.
+type Indexer struct {
+	db            *gorm.DB
+	client        access.Client
+	contract      string
+	startHeight   uint64
+	confirmations uint64
+	log           *log.Logger
+}
+
+// BlockEvent is a contract event with the block it was sealed in.
+type BlockEvent struct {
+	flow.Event
+	Height    uint64
+	Timestamp time.Time
+}
+
+// New builds an indexer of the PiggyBanks contract deployed at piggyAddress.
+// Without a checkpoint it starts at startHeight, or at the sealed head when it is zero.
+func New(db *gorm.DB, client access.Client, piggyAddress string, startHeight uint64, log *log.Logger) *Indexer {
+	return &Indexer{
+		db:            db,
+		client:        client,
+		contract:      fmt.Sprintf("A.%s.PiggyBanks", flow.HexToAddress(piggyAddress).Hex()),
+		startHeight:   startHeight,
+		confirmations: confirmations,
+		log:           log,
+	}
+}
+
+// SkipConfirmations makes the indexer follow the sealed head, for a chain that seals every block
+// as it is built, as the embedded emulator.
+func (i *Indexer) SkipConfirmations() *Indexer {
+	i.confirmations = 0
+	return i
+}
+
+// Reset drops the checkpoint, so the indexer starts over on a chain restarted from its genesis.
+func Reset(db *gorm.DB) error {
+	return db.Delete(&entities.IndexerCheckpoint{}, "name = ?", checkpointName).Error
+}
+
+// Start syncs the events periodically until ctx is done.
+func (i *Indexer) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			if err := i.Sync(ctx); err != nil && ctx.Err() == nil {
+				i.log.Println("indexer: " + err.Error())
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Sync applies the events of every block after the checkpoint, one range at a time.
+func (i *Indexer) Sync(ctx context.Context) error {
+	header, err := i.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return err
+	}
+	if header.Height < i.confirmations {
+		return nil
+	}
+	head := header.Height - i.confirmations
+
+	checkpoint, err := i.checkpoint(head)
+	if err != nil {
+		return err
+	}
+	for checkpoint.Height < head {
+		from := checkpoint.Height + 1
+		to := from + maxRange - 1
+		if to > head {
+			to = head
+		}
+		events, err := i.fetch(ctx, from, to)
+		if err != nil {
+			return err
+		}
+		checkpoint.Height = to
+		err = i.db.Transaction(func(tx *gorm.DB) error {
+			for _, event := range events {
+				if err := i.apply(tx, event); err != nil {
+					return fmt.Errorf("cannot apply %s of transaction %s: %w", event.Type, event.TransactionID, err)
+				}
+			}
+			return tx.Save(checkpoint).Error
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *Indexer) checkpoint(head uint64) (*entities.IndexerCheckpoint, error) {
+	checkpoint := entities.IndexerCheckpoint{Name: checkpointName}
+	err := i.db.First(&checkpoint, "name = ?", checkpointName).Error
+	if gorm.IsRecordNotFoundError(err) {
+		checkpoint.Height = head
+		if i.startHeight > 0 {
+			checkpoint.Height = i.startHeight - 1
+		}
+		return &checkpoint, i.db.Create(&checkpoint).Error
+	}
+	return &checkpoint, err
+}
+
+// fetch returns the contract events sealed between the heights, in the order they were emitted.
+func (i *Indexer) fetch(ctx context.Context, from uint64, to uint64) ([]BlockEvent, error) {
+	events := []BlockEvent{}
+	for _, name := range eventNames {
+		blocks, err := i.client.GetEventsForHeightRange(ctx, i.contract+"."+name, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blocks {
+			for _, event := range block.Events {
+				events = append(events, BlockEvent{Event: event, Height: block.Height, Timestamp: block.BlockTimestamp})
+			}
+		}
+	}
+	SortEvents(events)
+	return events, nil
+}
+
+// SortEvents orders events by block, transaction and position in the transaction.
+func SortEvents(events []BlockEvent) {
+	sort.SliceStable(events, func(a, b int) bool {
+		if events[a].Height != events[b].Height {
+			return events[a].Height < events[b].Height
+		}
+		if events[a].TransactionIndex != events[b].TransactionIndex {
+			return events[a].TransactionIndex < events[b].TransactionIndex
+		}
+		return events[a].EventIndex < events[b].EventIndex
+	})
+}
+
+func (i *Indexer) apply(tx *gorm.DB, event BlockEvent) error {
+	fields := event.Value.Fields
+	switch event.Type[strings.LastIndex(event.Type, ".")+1:] {
+	case PiggyCreated:
+		return upsertPiggy(tx, uint(fieldUint32(fields, 0)), fieldMetadata(fields, 1))
+	case DonationMinted:
+		return upsertDonation(tx, fieldUint64(fields, 0), uint(fieldUint32(fields, 1)), fieldUint32(fields, 2), event.Timestamp)
+	case SetBroken:
+		return tx.Model(&entities.Piggy{}).Where("id = ?", fieldUint32(fields, 0)).Update("broken", true).Error
+	case Withdraw:
+		return setOwner(tx, fieldUint64(fields, 0), "")
+	case Deposit:
+		return setOwner(tx, fieldUint64(fields, 0), fieldAddress(fields, 1))
+	case DonationDestroyed:
+		return tx.Model(&entities.Donation{}).Where("nft_id = ?", fieldUint64(fields, 0)).
+			Updates(map[string]interface{}{"destroyed": true, "owner_address": ""}).Error
+	}
+	return nil
+}
+
+func upsertPiggy(tx *gorm.DB, id uint, metadata map[string]string) error {
+	piggy := entities.Piggy{}
+	err := tx.First(&piggy, "id = ?", id).Error
+	if err == nil || !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	piggy = entities.Piggy{
+		Name:        metadata["Name"],
+		Description: metadata["Description"],
+		UserAddress: metadata["Creator"],
+	}
+	piggy.ID = id
+	return tx.Create(&piggy).Error
+}
+
+// upsertDonation stores the serial of a minted NFT, creating the donation when it was minted outside the api.
+func upsertDonation(tx *gorm.DB, nftID uint64, piggyID uint, serialNumber uint32, mintedAt time.Time) error {
+	donation := entities.Donation{}
+	err := tx.First(&donation, "nft_id = ?", nftID).Error
+	if err == nil {
+		return tx.Model(&donation).Update("serial_number", serialNumber).Error
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	mintedAt = mintedAt.UTC()
+	donation = entities.Donation{
+		PiggyID:         piggyID,
+		NftID:           nftID,
+		SerialNumber:    serialNumber,
+		Status:          entities.DonationMinted,
+		MintedAt:        &mintedAt,
+		StatusUpdatedAt: &mintedAt,
+	}
+	return tx.Create(&donation).Error
+}
+
+func setOwner(tx *gorm.DB, nftID uint64, owner string) error {
+	return tx.Model(&entities.Donation{}).Where("nft_id = ?", nftID).Update("owner_address", owner).Error
+}
+
+func fieldUint32(fields []cadence.Value, index int) uint32 {
+	if index < len(fields) {
+		if value, ok := fields[index].(cadence.UInt32); ok {
+			return uint32(value)
+		}
+	}
+	return 0
+}
+
+func fieldUint64(fields []cadence.Value, index int) uint64 {
+	if index < len(fields) {
+		if value, ok := fields[index].(cadence.UInt64); ok {
+			return uint64(value)
+		}
+	}
+	return 0
+}
+
+// fieldAddress returns an optional address field in the format user addresses are stored, empty when nil.
+func fieldAddress(fields []cadence.Value, index int) string {
+	if index >= len(fields) {
+		return ""
+	}
+	value := fields[index]
+	if optional, ok := value.(cadence.Optional); ok {
+		value = optional.Value
+	}
+	if address, ok := value.(cadence.Address); ok {
+		return flow.Address(address).Hex()
+	}
+	return ""
+}
+
+func fieldMetadata(fields []cadence.Value, index int) map[string]string {
+	metadata := map[string]string{}
+	if index >= len(fields) {
+		return metadata
+	}
+	dictionary, ok := fields[index].(cadence.Dictionary)
+	if !ok {
+		return metadata
+	}
+	for _, pair := range dictionary.Pairs {
+		key, keyOk := pair.Key.(cadence.String)
+		value, valueOk := pair.Value.(cadence.String)
+		if keyOk && valueOk {
+			metadata[string(key)] = string(value)
+		}
+	}
+	return metadata
+}
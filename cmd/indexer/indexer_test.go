@@ -0,0 +1,101 @@
+package indexer
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	blockchainservices "github.com/manubidegain/piggy-api/cmd/blockchain-services"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	flowUtils "github.com/manubidegain/piggy-api/flow"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortEvents(t *testing.T) {
+	events := []BlockEvent{
+		{Height: 11, Event: flow.Event{Type: Deposit, TransactionIndex: 0, EventIndex: 0}},
+		{Height: 10, Event: flow.Event{Type: Deposit, TransactionIndex: 1, EventIndex: 1}},
+		{Height: 10, Event: flow.Event{Type: DonationMinted, TransactionIndex: 1, EventIndex: 0}},
+		{Height: 10, Event: flow.Event{Type: PiggyCreated, TransactionIndex: 0, EventIndex: 0}},
+	}
+	SortEvents(events)
+
+	types := []string{}
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []string{PiggyCreated, DonationMinted, Deposit, Deposit}, types)
+	assert.Equal(t, uint64(11), events[3].Height)
+}
+
+func TestEventFields(t *testing.T) {
+	address := flow.HexToAddress("36e55122ece3464c")
+	name, _ := cadence.NewString("Name")
+	value, _ := cadence.NewString("Trip")
+	fields := []cadence.Value{
+		cadence.NewUInt64(42),
+		cadence.NewOptional(cadence.NewAddress(address)),
+		cadence.NewOptional(nil),
+		cadence.NewUInt32(7),
+		cadence.NewDictionary([]cadence.KeyValuePair{{Key: name, Value: value}}),
+	}
+
+	assert.Equal(t, uint64(42), fieldUint64(fields, 0))
+	assert.Equal(t, "36e55122ece3464c", fieldAddress(fields, 1))
+	assert.Equal(t, "", fieldAddress(fields, 2))
+	assert.Equal(t, uint32(7), fieldUint32(fields, 3))
+	assert.Equal(t, map[string]string{"Name": "Trip"}, fieldMetadata(fields, 4))
+
+	assert.Equal(t, uint32(0), fieldUint32(fields, 0))
+	assert.Equal(t, uint64(0), fieldUint64(fields, 9))
+	assert.Empty(t, fieldMetadata(fields, 0))
+}
+
+func TestSyncEmbeddedEmulator(t *testing.T) {
+	ctx := context.Background()
+	_, err := flowUtils.StartEmbeddedEmulator(ctx)
+	require.NoError(t, err)
+	config := &configuration.FlowConfig{}
+	profile := utils.Development
+	logger := log.New(io.Discard, "", 0)
+	client, err := utils.ConnectToFlow(profile, config)
+	require.NoError(t, err)
+
+	db, err := gorm.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	// every connection to :memory: opens a different database
+	db.DB().SetMaxOpenConns(1)
+	defer db.Close()
+	require.NoError(t, db.AutoMigrate(&entities.Piggy{}, &entities.Donation{}, &entities.IndexerCheckpoint{}).Error)
+
+	creator, err := blockchainservices.CreateAccount(ctx, profile, config, logger, &configuration.ProjectConfig{})
+	require.NoError(t, err)
+	piggyID, err := blockchainservices.CreateBlockchainPiggy(creator, "Trip", "Saving for the trip", config, profile, ctx, logger)
+	require.NoError(t, err)
+	piggyAddress := flowUtils.NewEnv(profile, config).PiggyAddress
+
+	// the emulator has sealed fewer blocks than the confirmations, nothing is indexed yet
+	require.NoError(t, New(db, client, piggyAddress, 1, logger).Sync(ctx))
+	count := 0
+	require.NoError(t, db.Model(&entities.Piggy{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	require.NoError(t, New(db, client, piggyAddress, 1, logger).SkipConfirmations().Sync(ctx))
+	piggy := entities.Piggy{}
+	require.NoError(t, db.First(&piggy, "id = ?", piggyID).Error)
+	assert.Equal(t, "Trip", piggy.Name)
+	assert.Equal(t, creator, piggy.UserAddress)
+
+	require.NoError(t, Reset(db))
+	checkpoints := 0
+	require.NoError(t, db.Model(&entities.IndexerCheckpoint{}).Count(&checkpoints).Error)
+	assert.Zero(t, checkpoints)
+}
@@ -0,0 +1,198 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+)
+
+const (
+	pollInterval = 2 * time.Second
+	jobTimeout   = 5 * time.Minute
+	// staleAfter is how long a job runs before it is taken for interrupted. Every worker gives up
+	// on its job after jobTimeout, the rest is a grace to save its outcome.
+	staleAfter = jobTimeout + time.Minute
+)
+
+// Handler runs a job of a given type and returns the value stored as its result.
+type Handler func(ctx context.Context, job *entities.Job) (interface{}, error)
+
+// Queue is a job queue persisted in the database and consumed by worker goroutines.
+type Queue struct {
+	db       *gorm.DB
+	log      *log.Logger
+	workers  int
+	handlers map[string]Handler
+	wakeup   chan struct{}
+	wg       sync.WaitGroup
+}
+
+func NewQueue(db *gorm.DB, workers int, log *log.Logger) *Queue {
+	return &Queue{
+		db:       db,
+		log:      log,
+		workers:  workers,
+		handlers: map[string]Handler{},
+		wakeup:   make(chan struct{}, workers),
+	}
+}
+
+// Register sets the handler for a job type, it must be called before Start.
+func (q *Queue) Register(jobType string, handler Handler) {
+	q.handlers[jobType] = handler
+}
+
+// Enqueue stores a new job with its JSON encoded payload and wakes up an idle worker.
+func (q *Queue) Enqueue(jobType string, userID string, payload interface{}) (*entities.Job, error) {
+	if _, ok := q.handlers[jobType]; !ok {
+		return nil, fmt.Errorf("unknown job type %s", jobType)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	job := entities.Job{Type: jobType, Status: entities.JobQueued, UserID: userID, Payload: string(data)}
+	if err := q.db.Create(&job).Error; err != nil {
+		return nil, err
+	}
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+	return &job, nil
+}
+
+// Start launches the workers until ctx is done, failing meanwhile the jobs interrupted by a shutdown.
+func (q *Queue) Start(ctx context.Context) {
+	q.failStale(time.Now().UTC())
+	q.wg.Add(1)
+	go q.reap(ctx)
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go q.work(ctx)
+	}
+}
+
+// reap fails the stale jobs periodically, the ones of a worker that stopped after Start as well.
+func (q *Queue) reap(ctx context.Context) {
+	defer q.wg.Done()
+	ticker := time.NewTicker(staleAfter)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			q.failStale(now.UTC())
+		}
+	}
+}
+
+// failStale fails the jobs running for longer than staleAfter at now. The database is shared
+// with the workers of other instances, so the jobs running for less may still finish.
+// A running job may have sent a transaction already, so it is not safe to run it again.
+func (q *Queue) failStale(now time.Time) {
+	err := q.db.Model(&entities.Job{}).
+		Where("status = ? AND (started_at IS NULL OR started_at < ?)", entities.JobRunning, now.Add(-staleAfter)).
+		Updates(map[string]interface{}{"status": entities.JobFailed, "error": "interrupted by a restart", "finished_at": &now}).Error
+	if err != nil {
+		q.log.Println("cannot fail interrupted jobs: " + err.Error())
+	}
+}
+
+// Wait blocks until every worker has stopped.
+func (q *Queue) Wait() {
+	q.wg.Wait()
+}
+
+func (q *Queue) work(ctx context.Context) {
+	defer q.wg.Done()
+	for {
+		job, err := q.claim()
+		if err != nil {
+			q.log.Println("cannot claim job: " + err.Error())
+		}
+		if job != nil {
+			q.run(ctx, job)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wakeup:
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// claim takes the oldest queued job, the conditional update keeps two workers from taking the same job.
+func (q *Queue) claim() (*entities.Job, error) {
+	for {
+		job := entities.Job{}
+		query := q.db.Where("status = ?", entities.JobQueued).Order("id").First(&job)
+		if query.RecordNotFound() {
+			return nil, nil
+		}
+		if query.Error != nil {
+			return nil, query.Error
+		}
+		now := time.Now().UTC()
+		claim := q.db.Model(&entities.Job{}).
+			Where("id = ? AND status = ?", job.ID, entities.JobQueued).
+			Updates(map[string]interface{}{"status": entities.JobRunning, "started_at": &now})
+		if claim.Error != nil {
+			return nil, claim.Error
+		}
+		if claim.RowsAffected == 1 {
+			job.Status = entities.JobRunning
+			job.StartedAt = &now
+			return &job, nil
+		}
+	}
+}
+
+func (q *Queue) run(ctx context.Context, job *entities.Job) {
+	jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+	defer cancel()
+
+	result, err := q.execute(jobCtx, job)
+	finishedAt := time.Now().UTC()
+	job.FinishedAt = &finishedAt
+	if err != nil {
+		q.log.Printf("job %d (%s) failed: %s", job.ID, job.Type, err.Error())
+		job.Status = entities.JobFailed
+		job.Error = err.Error()
+	} else {
+		job.Status = entities.JobSucceeded
+		data, err := json.Marshal(result)
+		if err != nil {
+			job.Status = entities.JobFailed
+			job.Error = err.Error()
+		}
+		job.Result = string(data)
+	}
+	if err := q.db.Save(job).Error; err != nil {
+		q.log.Printf("cannot save job %d: %s", job.ID, err.Error())
+	}
+}
+
+// execute runs the job handler, turning its panics into job errors so a worker never brings the API down.
+func (q *Queue) execute(ctx context.Context, job *entities.Job) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return q.handlers[job.Type](ctx, job)
+}
+
+// DecodePayload unmarshals the payload of a job into v.
+func DecodePayload(job *entities.Job, v interface{}) error {
+	return json.Unmarshal([]byte(job.Payload), v)
+}
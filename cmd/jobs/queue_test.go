@@ -0,0 +1,147 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/manubidegain/piggy-api/cmd/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueue(t *testing.T, workers int) *Queue {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	// every connection to :memory: opens a different database
+	db.DB().SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, db.AutoMigrate(&entities.Job{}).Error)
+	return NewQueue(db, workers, log.New(io.Discard, "", 0))
+}
+
+// storedJob reads the job as the queue saved it.
+func storedJob(t *testing.T, queue *Queue, id uint) entities.Job {
+	job := entities.Job{}
+	require.NoError(t, queue.db.First(&job, id).Error)
+	return job
+}
+
+func TestClaimIsExclusive(t *testing.T) {
+	queue := newTestQueue(t, 0)
+	queue.Register("noop", func(ctx context.Context, job *entities.Job) (interface{}, error) { return nil, nil })
+	for i := 0; i < 20; i++ {
+		_, err := queue.Enqueue("noop", "uid", nil)
+		require.NoError(t, err)
+	}
+
+	claimed := make(chan uint, 40)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				job, err := queue.claim()
+				if !assert.NoError(t, err) || job == nil {
+					return
+				}
+				assert.Equal(t, entities.JobRunning, job.Status)
+				claimed <- job.ID
+			}
+		}()
+	}
+	wg.Wait()
+	close(claimed)
+
+	seen := map[uint]bool{}
+	for id := range claimed {
+		assert.False(t, seen[id], "job %d claimed twice", id)
+		seen[id] = true
+	}
+	assert.Len(t, seen, 20)
+}
+
+func TestRunRecordsOutcome(t *testing.T) {
+	queue := newTestQueue(t, 2)
+	queue.Register("succeed", func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		payload := map[string]string{}
+		if err := DecodePayload(job, &payload); err != nil {
+			return nil, err
+		}
+		return map[string]string{"echo": payload["value"]}, nil
+	})
+	queue.Register("fail", func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		return nil, errors.New("chain unavailable")
+	})
+	queue.Register("panic", func(ctx context.Context, job *entities.Job) (interface{}, error) {
+		panic("nil collection")
+	})
+	_, err := queue.Enqueue("unknown", "uid", nil)
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue.Start(ctx)
+	defer func() {
+		cancel()
+		queue.Wait()
+	}()
+	succeeded, err := queue.Enqueue("succeed", "uid", map[string]string{"value": "piggy"})
+	require.NoError(t, err)
+	failed, err := queue.Enqueue("fail", "uid", nil)
+	require.NoError(t, err)
+	panicked, err := queue.Enqueue("panic", "uid", nil)
+	require.NoError(t, err)
+
+	finished := func(id uint) func() bool {
+		return func() bool { return storedJob(t, queue, id).FinishedAt != nil }
+	}
+	for _, id := range []uint{succeeded.ID, failed.ID, panicked.ID} {
+		require.Eventually(t, finished(id), 5*time.Second, 10*time.Millisecond)
+	}
+	job := storedJob(t, queue, succeeded.ID)
+	assert.Equal(t, entities.JobSucceeded, job.Status)
+	assert.JSONEq(t, `{"echo": "piggy"}`, job.Result)
+	assert.NotNil(t, job.StartedAt)
+	job = storedJob(t, queue, failed.ID)
+	assert.Equal(t, entities.JobFailed, job.Status)
+	assert.Equal(t, "chain unavailable", job.Error)
+	job = storedJob(t, queue, panicked.ID)
+	assert.Equal(t, entities.JobFailed, job.Status)
+	assert.Contains(t, job.Error, "nil collection")
+}
+
+func TestFailStaleSparesRecentJobs(t *testing.T) {
+	queue := newTestQueue(t, 0)
+	now := time.Now().UTC()
+	stale := now.Add(-staleAfter - time.Minute)
+	recent := now.Add(-time.Minute)
+	jobs := map[string]*entities.Job{
+		"stale":    {Type: "noop", Status: entities.JobRunning, StartedAt: &stale},
+		"recent":   {Type: "noop", Status: entities.JobRunning, StartedAt: &recent},
+		"queued":   {Type: "noop", Status: entities.JobQueued},
+		"finished": {Type: "noop", Status: entities.JobSucceeded, StartedAt: &stale, FinishedAt: &stale},
+	}
+	for _, job := range jobs {
+		require.NoError(t, queue.db.Create(job).Error)
+	}
+
+	queue.failStale(now)
+	job := storedJob(t, queue, jobs["stale"].ID)
+	assert.Equal(t, entities.JobFailed, job.Status)
+	assert.Equal(t, "interrupted by a restart", job.Error)
+	// another instance may still be running it
+	assert.Equal(t, entities.JobRunning, storedJob(t, queue, jobs["recent"].ID).Status)
+	assert.Equal(t, entities.JobQueued, storedJob(t, queue, jobs["queued"].ID).Status)
+	assert.Equal(t, entities.JobSucceeded, storedJob(t, queue, jobs["finished"].ID).Status)
+
+	// the jobs of a worker that stopped meanwhile go stale later
+	queue.failStale(now.Add(staleAfter))
+	assert.Equal(t, entities.JobFailed, storedJob(t, queue, jobs["recent"].ID).Status)
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/firebase"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+	"github.com/manubidegain/piggy-api/utils"
+)
+
+// Run prints a token the local verifier of the profile accepts, to call the api as any user.
+// Usage: token -uid <uid> [-email <email>] [-roles admin,creator,donor] [-user-id <id>] [-ttl 24h] [-profile dev]
+func Run(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("token", flag.ContinueOnError)
+	flags.SetOutput(out)
+	profile := flags.String("profile", defaultProfile(), "profile whose auth config signs the token")
+	uid := flags.String("uid", "", "UID of the user, the UUID of the requests")
+	email := flags.String("email", "", "email of the user")
+	roles := flags.String("roles", "", "comma separated roles of the memberRoles claim")
+	userID := flags.String("user-id", "", "userId claim")
+	ttl := flags.Duration("ttl", 24*time.Hour, "time until the token expires")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *uid == "" {
+		return errors.New("token needs the -uid of the user")
+	}
+
+	config := configuration.BuildConfig(*profile)
+	if *profile != utils.Development || config.Auth == nil || config.Auth.Verifier != firebase.LocalVerifierType {
+		return fmt.Errorf("the %s profile does not verify local tokens", *profile)
+	}
+	verifier, err := firebase.NewLocalVerifier([]byte(config.Auth.LocalKey))
+	if err != nil {
+		return err
+	}
+
+	claims := map[string]interface{}{}
+	if *roles != "" {
+		granted := strings.Split(*roles, ",")
+		for _, role := range granted {
+			if !middlewares.ValidRole(role) {
+				return fmt.Errorf("unknown role %q", role)
+			}
+		}
+		claims[middlewares.MemberRolesClaim] = middlewares.RolesClaim(granted)
+		claims["userRoles"] = granted
+	}
+	if *userID != "" {
+		claims["userId"] = *userID
+	}
+	signed, err := verifier.Sign(firebase.Token{UID: *uid, Email: *email, Expires: time.Now().Add(*ttl), Claims: claims})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, signed)
+	return nil
+}
+
+func defaultProfile() string {
+	if os.Getenv("SCOPE") == "" {
+		return utils.Development
+	}
+	return utils.CalculateProfile()
+}
@@ -2,59 +2,152 @@ package firebase
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"firebase.google.com/go/auth"
 	"github.com/gin-gonic/gin"
 )
 
-// AuthMiddleware : to verify all authorized operations
-func AuthMiddleware(c *gin.Context) {
-	firebaseAuth := c.MustGet("firebaseAuth").(*auth.Client)
+// Token is a verified request token: the user it identifies and its claims, the custom ones included.
+// Email and Phone are empty when the user did not sign in with them, as anonymous users,
+// and Email as well until the user verifies it.
+type Token struct {
+	UID      string
+	Email    string
+	Phone    string
+	Provider string
+	Expires  time.Time
+	Claims   map[string]interface{}
+}
 
-	authorizationToken := c.GetHeader("Authorization")
-	reqToken := strings.TrimSpace(strings.Replace(authorizationToken, "Bearer", "", 1))
+// TokenVerifier verifies the bearer tokens of the requests.
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (*Token, error)
+}
 
-	if reqToken == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Token not available"})
-		c.Abort()
-		return
+// AuthMiddleware : to verify all authorized operations
+// The verified tokens are cached until they expire, so a token is verified once however many requests carry it.
+func AuthMiddleware(verifier TokenVerifier) gin.HandlerFunc {
+	cache := newTokenCache(verifier)
+	return func(c *gin.Context) {
+		authorizationToken := c.GetHeader("Authorization")
+		reqToken := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(authorizationToken), "Bearer"))
+
+		if reqToken == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token not available"})
+			c.Abort()
+			return
+		}
+
+		token, err := cache.VerifyToken(c, reqToken)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+
+		c.Set("UUID", token.UID)
+		c.Set("userEmail", token.Email)
+		c.Set("userPhone", token.Phone)
+
+		// set custom claims to request context
+		c.Set("userId", token.Claims["userId"])
+		c.Set("userRoles", token.Claims["userRoles"])
+		c.Set("partnerId", token.Claims["partnerId"])
+		c.Set("memberRoles", token.Claims["memberRoles"])
+
+		c.Next()
 	}
+}
 
-	// verify and get token
-	idToken, err := signInWithCustomToken(reqToken)
-	if err != nil {
-		idToken = reqToken
-	}
+// FirebaseVerifier verifies Firebase ID tokens against the public keys of Firebase, which the admin SDK caches.
+// Custom tokens minted by the admin SDK are exchanged for an ID token first.
+type FirebaseVerifier struct {
+	client *auth.Client
+}
+
+func NewFirebaseVerifier(client *auth.Client) *FirebaseVerifier {
+	return &FirebaseVerifier{client: client}
+}
 
-	fmt.Printf("Firebase idToken %s", idToken)
+func (v *FirebaseVerifier) VerifyToken(ctx context.Context, reqToken string) (*Token, error) {
+	idToken := reqToken
+	if isCustomToken(reqToken) {
+		exchanged, err := signInWithCustomToken(reqToken)
+		if err != nil {
+			return nil, fmt.Errorf("cannot sign in with the custom token: %w", err)
+		}
+		idToken = exchanged
+	}
 
-	token, err := firebaseAuth.VerifyIDToken(c, idToken)
+	token, err := v.client.VerifyIDToken(ctx, idToken)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		c.Abort()
-		return
+		return nil, err
 	}
 
-	fmt.Printf("Token uid %s", token.UID)
+	return &Token{
+		UID:      token.UID,
+		Email:    verifiedEmail(token),
+		Phone:    identity(token, "phone_number", "phone"),
+		Provider: token.Firebase.SignInProvider,
+		Expires:  time.Unix(token.Expires, 0),
+		Claims:   token.Claims,
+	}, nil
+}
 
-	c.Set("UUID", token.UID)
+// customTokenAudience is the audience of the custom tokens the admin SDK signs, ID tokens have the project ID.
+const customTokenAudience = "https://identitytoolkit.googleapis.com/google.identity.identitytoolkit.v1.IdentityToolkit"
 
-	emails := token.Firebase.Identities["email"].([]interface{})
-	c.Set("userEmail", emails[0].(string))
+// isCustomToken reads without verifying it whether token is a custom token rather than an ID token.
+func isCustomToken(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	var claims struct {
+		Audience interface{} `json:"aud"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return false
+	}
+	return claims.Audience == customTokenAudience
+}
 
-	// set custom claims to request context
-	c.Set("userId", token.Claims["userId"])
-	c.Set("userRoles", token.Claims["userRoles"])
-	c.Set("partnerId", token.Claims["partnerId"])
-	c.Set("memberRoles", token.Claims["memberRoles"])
+// verifiedEmail returns the email of the token once its owner verified it, and empty before,
+// as anyone can sign up with an address they do not own.
+func verifiedEmail(token *auth.Token) string {
+	if verified, _ := token.Claims["email_verified"].(bool); !verified {
+		return ""
+	}
+	return identity(token, "email", "email")
+}
 
-	c.Next()
+// identity returns the claim of the token, or else the first identity of kind the user signed in with,
+// empty when it has none as anonymous users.
+func identity(token *auth.Token, claim string, kind string) string {
+	if value, ok := token.Claims[claim].(string); ok && value != "" {
+		return value
+	}
+	identities, _ := token.Firebase.Identities[kind].([]interface{})
+	for _, identity := range identities {
+		if value, ok := identity.(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
 }
 
 const (
@@ -83,7 +176,10 @@ func signInWithCustomToken(token string) (string, error) {
 		return "", err
 	}
 
-	return respBody.IDToken, err
+	if respBody.IDToken == "" {
+		return "", errors.New("no ID token for the custom token")
+	}
+	return respBody.IDToken, nil
 }
 
 func postRequest(url string, body []byte) ([]byte, error) {
@@ -0,0 +1,100 @@
+package firebase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"firebase.google.com/go/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingVerifier accepts every token but "bad" until expires, counting the verifications.
+type countingVerifier struct {
+	calls   int
+	expires time.Time
+}
+
+func (v *countingVerifier) VerifyToken(ctx context.Context, token string) (*Token, error) {
+	v.calls++
+	if token == "bad" {
+		return nil, errors.New("bad token")
+	}
+	return &Token{UID: token, Expires: v.expires}, nil
+}
+
+func TestTokenCache(t *testing.T) {
+	now := time.Now()
+	verifier := &countingVerifier{expires: now.Add(time.Hour)}
+	cache := newTokenCache(verifier)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		token, err := cache.VerifyToken(context.Background(), "uid-1")
+		require.NoError(t, err)
+		assert.Equal(t, "uid-1", token.UID)
+	}
+	assert.Equal(t, 1, verifier.calls)
+
+	// rejected tokens are verified again on every request
+	for i := 0; i < 2; i++ {
+		_, err := cache.VerifyToken(context.Background(), "bad")
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 3, verifier.calls)
+
+	// past its expiry the cached token is evicted and rejected
+	now = now.Add(time.Hour)
+	_, err := cache.VerifyToken(context.Background(), "uid-1")
+	assert.ErrorIs(t, err, ErrExpiredToken)
+	assert.Equal(t, 3, verifier.calls)
+	assert.Empty(t, cache.tokens)
+
+	// a verifier accepting an expired token does not make it valid
+	_, err = cache.VerifyToken(context.Background(), "uid-1")
+	assert.ErrorIs(t, err, ErrExpiredToken)
+	assert.Equal(t, 4, verifier.calls)
+	assert.Empty(t, cache.tokens)
+}
+
+func fakeJWT(payload string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." + encode([]byte(payload)) + ".signature"
+}
+
+func TestIsCustomToken(t *testing.T) {
+	assert.True(t, isCustomToken(fakeJWT(`{"aud":"`+customTokenAudience+`","uid":"uid-1"}`)))
+	assert.False(t, isCustomToken(fakeJWT(`{"aud":"piggy-project","iss":"https://securetoken.google.com/piggy-project"}`)))
+	assert.False(t, isCustomToken(fakeJWT(`{"aud":["piggy-project"]}`)))
+	assert.False(t, isCustomToken("not-a-token"))
+}
+
+func TestIdentity(t *testing.T) {
+	email := &auth.Token{Claims: map[string]interface{}{}}
+	email.Firebase.Identities = map[string]interface{}{"email": []interface{}{"ana@piggy.test"}}
+	assert.Equal(t, "ana@piggy.test", identity(email, "email", "email"))
+	assert.Equal(t, "", identity(email, "phone_number", "phone"))
+
+	phone := &auth.Token{Claims: map[string]interface{}{"phone_number": "+5491100000000"}}
+	phone.Firebase.Identities = map[string]interface{}{"phone": []interface{}{"+5491100000000"}}
+	assert.Equal(t, "", identity(phone, "email", "email"))
+	assert.Equal(t, "+5491100000000", identity(phone, "phone_number", "phone"))
+
+	anonymous := &auth.Token{Claims: map[string]interface{}{}}
+	anonymous.Firebase.SignInProvider = "anonymous"
+	assert.Equal(t, "", identity(anonymous, "email", "email"))
+}
+
+func TestVerifiedEmail(t *testing.T) {
+	verified := &auth.Token{Claims: map[string]interface{}{"email": "ana@piggy.test", "email_verified": true}}
+	assert.Equal(t, "ana@piggy.test", verifiedEmail(verified))
+
+	unverified := &auth.Token{Claims: map[string]interface{}{"email": "ana@piggy.test", "email_verified": false}}
+	assert.Equal(t, "", verifiedEmail(unverified))
+	unclaimed := &auth.Token{Claims: map[string]interface{}{"email": "ana@piggy.test"}}
+	unclaimed.Firebase.Identities = map[string]interface{}{"email": []interface{}{"ana@piggy.test"}}
+	assert.Equal(t, "", verifiedEmail(unclaimed))
+}
@@ -0,0 +1,66 @@
+package firebase
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+// maxCachedTokens bounds the verified tokens kept, the expired ones are dropped when it is reached.
+const maxCachedTokens = 10000
+
+// tokenCache keeps the tokens its verifier accepted until they expire. The tokens are keyed by their
+// hash, and a revoked token stays valid until it expires as with the Firebase verification without revocation check.
+type tokenCache struct {
+	verifier TokenVerifier
+	now      func() time.Time
+
+	mu     sync.Mutex
+	tokens map[[sha256.Size]byte]*Token
+}
+
+func newTokenCache(verifier TokenVerifier) *tokenCache {
+	return &tokenCache{verifier: verifier, now: time.Now, tokens: map[[sha256.Size]byte]*Token{}}
+}
+
+func (c *tokenCache) VerifyToken(ctx context.Context, reqToken string) (*Token, error) {
+	key := sha256.Sum256([]byte(reqToken))
+	now := c.now()
+
+	// an expired token is rejected, cached or not
+	c.mu.Lock()
+	token, ok := c.tokens[key]
+	if ok && !now.Before(token.Expires) {
+		delete(c.tokens, key)
+		c.mu.Unlock()
+		return nil, ErrExpiredToken
+	}
+	c.mu.Unlock()
+	if ok {
+		return token, nil
+	}
+
+	token, err := c.verifier.VerifyToken(ctx, reqToken)
+	if err != nil {
+		return nil, err
+	}
+	if !now.Before(token.Expires) {
+		return nil, ErrExpiredToken
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.tokens) >= maxCachedTokens {
+		for cached, expiring := range c.tokens {
+			if !now.Before(expiring.Expires) {
+				delete(c.tokens, cached)
+			}
+		}
+		if len(c.tokens) >= maxCachedTokens {
+			c.tokens = map[[sha256.Size]byte]*Token{}
+		}
+	}
+	c.tokens[key] = token
+	return token, nil
+}
@@ -0,0 +1,38 @@
+package firebase
+
+import (
+	"context"
+	"fmt"
+
+	"firebase.google.com/go/auth"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+)
+
+// GetUserRoles returns the roles granted to the firebase user uid.
+func GetUserRoles(ctx context.Context, client *auth.Client, uid string) ([]string, error) {
+	user, err := client.GetUser(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return middlewares.RolesFromClaim(user.CustomClaims[middlewares.MemberRolesClaim]), nil
+}
+
+// SetUserRoles replaces the roles of the firebase user uid, keeping its other custom claims.
+// The roles reach the requests once the user refreshes its ID token.
+func SetUserRoles(ctx context.Context, client *auth.Client, uid string, roles []string) error {
+	for _, role := range roles {
+		if !middlewares.ValidRole(role) {
+			return fmt.Errorf("unknown role %q", role)
+		}
+	}
+	user, err := client.GetUser(ctx, uid)
+	if err != nil {
+		return err
+	}
+	claims := map[string]interface{}{}
+	for name, value := range user.CustomClaims {
+		claims[name] = value
+	}
+	claims[middlewares.MemberRolesClaim] = middlewares.RolesClaim(roles)
+	return client.SetCustomUserClaims(ctx, uid, claims)
+}
@@ -2,9 +2,18 @@ package firebase
 
 import (
 	"context"
+	"fmt"
 
 	firebase "firebase.google.com/go"
 	"firebase.google.com/go/auth"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/manubidegain/piggy-api/utils"
+)
+
+// Verifiers of the request tokens, selected by the auth config.
+const (
+	FirebaseVerifierType = "firebase"
+	LocalVerifierType    = "local"
 )
 
 func SetupFirebase() *auth.Client {
@@ -20,3 +29,22 @@ func SetupFirebase() *auth.Client {
 	}
 	return auth
 }
+
+// NewTokenVerifier returns the verifier selected by config, firebase verifying with client when there is none.
+// The local verifier is only accepted in the dev profile, anyone knowing its key can sign in as any user.
+func NewTokenVerifier(config *configuration.AuthConfig, profile string, client *auth.Client) (TokenVerifier, error) {
+	if config == nil {
+		config = &configuration.AuthConfig{}
+	}
+	switch config.Verifier {
+	case "", FirebaseVerifierType:
+		return NewFirebaseVerifier(client), nil
+	case LocalVerifierType:
+		if profile != utils.Development {
+			return nil, fmt.Errorf("the %s token verifier is only allowed in the %s profile, not %s", LocalVerifierType, utils.Development, profile)
+		}
+		return NewLocalVerifier([]byte(config.LocalKey))
+	default:
+		return nil, fmt.Errorf("unknown token verifier %q", config.Verifier)
+	}
+}
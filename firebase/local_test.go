@@ -0,0 +1,114 @@
+package firebase
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	middlewares "github.com/manubidegain/piggy-api/firebase/middlewares"
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVerifier(t *testing.T) *LocalVerifier {
+	verifier, err := NewLocalVerifier([]byte("test-signing-key"))
+	require.NoError(t, err)
+	return verifier
+}
+
+func TestLocalVerifier(t *testing.T) {
+	verifier := newTestVerifier(t)
+	claims := map[string]interface{}{
+		"userId":      "42",
+		"userRoles":   []string{middlewares.CreatorRole},
+		"memberRoles": middlewares.RolesClaim([]string{middlewares.CreatorRole}),
+	}
+	signed, err := verifier.Sign(Token{UID: "uid-1", Email: "ana@piggy.test", Expires: time.Now().Add(time.Hour), Claims: claims})
+	require.NoError(t, err)
+
+	token, err := verifier.VerifyToken(context.Background(), signed)
+	require.NoError(t, err)
+	assert.Equal(t, "uid-1", token.UID)
+	assert.Equal(t, "ana@piggy.test", token.Email)
+	assert.Equal(t, "42", token.Claims["userId"])
+	assert.Equal(t, []string{middlewares.CreatorRole}, middlewares.RolesFromClaim(token.Claims["memberRoles"]))
+
+	parts := strings.Split(signed, ".")
+	_, err = verifier.VerifyToken(context.Background(), parts[0]+"."+parts[1]+"x."+parts[2])
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = verifier.VerifyToken(context.Background(), "not-a-token")
+	assert.ErrorIs(t, err, ErrMalformedToken)
+
+	other, err := NewLocalVerifier([]byte("other-key"))
+	require.NoError(t, err)
+	_, err = other.VerifyToken(context.Background(), signed)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	expired, err := verifier.Sign(Token{UID: "uid-1", Expires: time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+	_, err = verifier.VerifyToken(context.Background(), expired)
+	assert.ErrorIs(t, err, ErrExpiredToken)
+
+	_, err = NewLocalVerifier(nil)
+	assert.Error(t, err)
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	verifier := newTestVerifier(t)
+	router := gin.New()
+	router.Use(AuthMiddleware(verifier))
+	router.GET("/admin", middlewares.Allow([]string{middlewares.AdminRole}), func(ctx *gin.Context) {
+		ctx.String(http.StatusOK, ctx.GetString("UUID")+" "+ctx.GetString("userEmail"))
+	})
+	serve := func(authorization string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "/admin", nil)
+		if authorization != "" {
+			request.Header.Set("Authorization", authorization)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	admin, err := verifier.Sign(Token{
+		UID:     "admin-uid",
+		Email:   "admin@piggy.test",
+		Expires: time.Now().Add(time.Hour),
+		Claims:  map[string]interface{}{"memberRoles": middlewares.RolesClaim([]string{middlewares.AdminRole})},
+	})
+	require.NoError(t, err)
+	recorder := serve("Bearer " + admin)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "admin-uid admin@piggy.test", recorder.Body.String())
+
+	donor, err := verifier.Sign(Token{UID: "donor-uid", Expires: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, serve("Bearer "+donor).Code)
+	assert.Equal(t, http.StatusUnauthorized, serve("").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve("Bearer "+donor+"x").Code)
+}
+
+func TestNewTokenVerifier(t *testing.T) {
+	local := &configuration.AuthConfig{Verifier: LocalVerifierType, LocalKey: "test-signing-key"}
+	verifier, err := NewTokenVerifier(local, utils.Development, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &LocalVerifier{}, verifier)
+
+	for _, profile := range []string{utils.Production, utils.Test, ""} {
+		_, err := NewTokenVerifier(local, profile, nil)
+		assert.Error(t, err, profile)
+	}
+
+	verifier, err = NewTokenVerifier(nil, utils.Production, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &FirebaseVerifier{}, verifier)
+	_, err = NewTokenVerifier(&configuration.AuthConfig{Verifier: "unknown"}, utils.Development, nil)
+	assert.Error(t, err)
+}
@@ -6,13 +6,15 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// Allow lets through the members with one of endpointRoles in their memberRoles claim.
+// Every member is a donor, the role every user has when signing up.
 func Allow(endpointRoles []string) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		memberRoles := ctx.GetStringMap("memberRoles")
+		memberRoles := MemberRoles(ctx)
 
 		allowed := false
 		for _, endpointRole := range endpointRoles {
-			for memberRole := range memberRoles {
+			for _, memberRole := range memberRoles {
 				if !allowed {
 					allowed = endpointRole == memberRole
 				}
@@ -20,7 +22,7 @@ func Allow(endpointRoles []string) gin.HandlerFunc {
 		}
 
 		if !allowed {
-			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
 			ctx.Abort()
 			return
 		}
@@ -0,0 +1,60 @@
+package firebase
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveWithRoles(claim interface{}, endpointRoles ...string) int {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		if claim != nil {
+			ctx.Set(MemberRolesClaim, claim)
+		}
+	})
+	router.GET("/", Allow(endpointRoles), func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	return recorder.Code
+}
+
+func TestAllow(t *testing.T) {
+	admin := map[string]interface{}{AdminRole: true}
+	creator := map[string]interface{}{CreatorRole: true, AdminRole: false}
+
+	assert.Equal(t, http.StatusOK, serveWithRoles(admin, AdminRole))
+	assert.Equal(t, http.StatusOK, serveWithRoles(creator, CreatorRole, AdminRole))
+	assert.Equal(t, http.StatusForbidden, serveWithRoles(creator, AdminRole))
+	// every member is a donor, with or without other roles
+	assert.Equal(t, http.StatusOK, serveWithRoles(nil, DonorRole))
+	assert.Equal(t, http.StatusOK, serveWithRoles(creator, DonorRole))
+	assert.Equal(t, http.StatusForbidden, serveWithRoles(nil, CreatorRole))
+	assert.Equal(t, http.StatusForbidden, serveWithRoles("admin", AdminRole))
+}
+
+func TestRolesClaim(t *testing.T) {
+	claim := RolesClaim([]string{DonorRole, CreatorRole})
+	assert.Equal(t, []string{CreatorRole, DonorRole}, RolesFromClaim(claim))
+	assert.True(t, ValidRole(AdminRole))
+	assert.False(t, ValidRole("owner"))
+}
+
+func TestMemberRoles(t *testing.T) {
+	memberRoles := func(claim interface{}) []string {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		if claim != nil {
+			ctx.Set(MemberRolesClaim, claim)
+		}
+		return MemberRoles(ctx)
+	}
+	assert.Equal(t, []string{DonorRole}, memberRoles(nil))
+	assert.Equal(t, []string{CreatorRole, DonorRole}, memberRoles(RolesClaim([]string{CreatorRole})))
+	assert.Equal(t, []string{AdminRole, DonorRole}, memberRoles(RolesClaim([]string{DonorRole, AdminRole})))
+}
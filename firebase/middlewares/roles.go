@@ -0,0 +1,71 @@
+package firebase
+
+import (
+	"sort"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Roles of the members, kept in the memberRoles custom claim as {"<role>": true}.
+const (
+	// AdminRole manages users, roles and the support endpoints.
+	AdminRole = "admin"
+	// CreatorRole creates and breaks piggies.
+	CreatorRole = "creator"
+	// DonorRole donates to piggies. Every member is a donor, granted or not.
+	DonorRole = "donor"
+)
+
+// MemberRolesClaim is the custom claim holding the roles of a member.
+const MemberRolesClaim = "memberRoles"
+
+// Roles are all the roles a member can be granted.
+var Roles = []string{AdminRole, CreatorRole, DonorRole}
+
+// ValidRole reports whether role is one of Roles.
+func ValidRole(role string) bool {
+	for _, valid := range Roles {
+		if role == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// RolesFromClaim returns the sorted roles set in a memberRoles claim.
+func RolesFromClaim(claim interface{}) []string {
+	roles := []string{}
+	if granted, ok := claim.(map[string]interface{}); ok {
+		for role, value := range granted {
+			if value == true {
+				roles = append(roles, role)
+			}
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// RolesClaim returns the memberRoles claim granting roles.
+func RolesClaim(roles []string) map[string]interface{} {
+	claim := map[string]interface{}{}
+	for _, role := range roles {
+		claim[role] = true
+	}
+	return claim
+}
+
+// MemberRoles returns the sorted roles of the authenticated member, donor always among them:
+// granting creator or admin adds to what a member does, it does not take donating away.
+func MemberRoles(ctx *gin.Context) []string {
+	claim, _ := ctx.Get(MemberRolesClaim)
+	roles := RolesFromClaim(claim)
+	for _, role := range roles {
+		if role == DonorRole {
+			return roles
+		}
+	}
+	roles = append(roles, DonorRole)
+	sort.Strings(roles)
+	return roles
+}
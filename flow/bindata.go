@@ -1,494 +0,0 @@
-// Code generated by go-bindata. DO NOT EDIT.
-// sources:
-// blockchain/transactions/admin/create_piggy.cdc (636B)
-// blockchain/transactions/admin/mint_donation.cdc (1.547kB)
-// blockchain/transactions/admin/transfer_admin.cdc (657B)
-// blockchain/contracts/FungibleToken.cdc (8.193kB)
-// blockchain/contracts/MetadataViews.cdc (26.318kB)
-// blockchain/contracts/NonFungibleToken.cdc (4.825kB)
-// blockchain/contracts/piggy.cdc (23.705kB)
-// blockchain/transactions/user/setup_account.cdc (1.03kB)
-// blockchain/transactions/scripts/get_nextPiggyID.cdc (101B)
-// blockchain/transactions/scripts/get_totalSupply.cdc (101B)
-
-package flow
-
-import (
-	"bytes"
-	"compress/gzip"
-	"crypto/sha256"
-	"fmt"
-	"io"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %w", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %w", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes  []byte
-	info   os.FileInfo
-	digest [sha256.Size]byte
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _blockchainTransactionsAdminCreate_piggyCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x41\x8f\x95\x40\x10\x84\xef\xfc\x8a\xca\x1e\x0c\x7b\x01\xcf\x44\xdd\xe0\xae\xd9\x98\x78\xd8\xec\xf3\xe2\xb1\x19\x1a\x18\x85\x19\xd2\xd3\xf8\xd6\x6c\xde\x7f\x37\xcc\x00\xcf\xc4\x98\xc8\x01\x48\xa8\xae\xfa\xaa\x07\x3b\xcd\x5e\x14\x4f\xb6\xef\x7f\x7d\x24\xf7\x23\xa0\x13\x3f\xe1\xed\xcb\xd3\xe7\xc7\xc7\x6f\xf5\xc3\xc3\xf3\xa7\xd3\x29\xcb\xb2\xb2\xc4\xd7\xc1\x06\xa8\x90\x0b\x64\xd4\x7a\x07\x1b\xd0\x79\x81\x0e\x0c\x6a\x27\xeb\xa0\x1e\x46\x98\x94\x41\x70\x7c\x4e\xae\x59\xf6\xc7\x4c\x3e\xb1\x52\x4b\x4a\x15\x5e\x4f\x2a\xd6\xf5\x15\xd2\xf3\x72\x8b\xd7\x0c\x00\xe2\xad\x2c\xf1\xc5\x1b\x1a\xf1\x93\xc4\x52\x33\xf2\x91\x34\xaf\x9e\x68\x22\x6a\x1d\x53\x7d\xf3\x9d\x8d\xc6\xb1\x91\x35\xa1\x3c\x73\x57\xe1\xcd\xb5\x55\x11\xa5\x59\x14\xcd\xc2\x33\x09\xe7\x64\x8c\x56\xa8\x17\x1d\x6a\x63\xfc\xe2\x74\x25\x88\x8a\x8d\xa0\xf1\x22\xfe\x0c\x82\x70\xc7\xc2\xce\xf0\xda\x70\x85\x48\xc1\xc2\xc1\x2f\x62\x18\xd6\x21\xa8\x17\xea\xf9\x18\x0f\x3c\x76\xc5\x8e\x82\xf7\x58\xc3\x8a\x64\xf8\xee\x2f\xae\x0f\xf9\xba\xf4\x0a\xe5\xe6\x52\x5e\x05\xf1\xfb\xed\x61\xbb\x5e\x77\x77\x98\xc9\x59\x93\xdf\xdc\xfb\x65\x6c\xe1\xbc\xfe\x37\xea\x4d\xb2\xba\xa4\x9e\xfc\xc2\x66\x51\xde\x16\x7f\x2c\x7f\xab\x7f\xbf\x1f\x65\x60\xc5\xd9\xea\x10\xfd\xc2\xcc\xc6\x76\x96\x5b\x38\x9a\xfe\xd1\xb7\x48\x7f\x41\x6c\x71\x3d\xf1\xfd\x65\x47\xb8\xfc\x0e\x00\x00\xff\xff\xa0\xdb\x96\xa8\x7c\x02\x00\x00")
-
-func blockchainTransactionsAdminCreate_piggyCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainTransactionsAdminCreate_piggyCdc,
-		"blockchain/transactions/admin/create_piggy.cdc",
-	)
-}
-
-func blockchainTransactionsAdminCreate_piggyCdc() (*asset, error) {
-	bytes, err := blockchainTransactionsAdminCreate_piggyCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/transactions/admin/create_piggy.cdc", size: 636, mode: os.FileMode(0644), modTime: time.Unix(1677640968, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x34, 0xa8, 0x9d, 0xce, 0x6c, 0xa0, 0x79, 0x11, 0xbd, 0x9b, 0xd4, 0x1c, 0x32, 0xf0, 0x72, 0xc4, 0x3d, 0x47, 0x89, 0x37, 0xc, 0x68, 0x83, 0xe, 0x33, 0xbc, 0x7e, 0x77, 0xab, 0x7f, 0xd6, 0xf8}}
-	return a, nil
-}
-
-var _blockchainTransactionsAdminMint_donationCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4b\x4f\x1b\x31\x10\xbe\xe7\x57\x8c\x38\xb4\x1b\x09\xb2\x7d\xdc\x22\x1e\x0a\x49\x41\x39\x14\x45\x40\x0f\x3d\x4e\xbc\x93\xc4\xc5\x6b\xaf\xec\xd9\x84\x08\xf1\xdf\xab\xd9\x87\xb3\x4b\xa0\x2a\xe2\x10\xcd\xce\xcc\xf7\xf0\x67\xeb\xbc\x70\x9e\x61\xa1\xd7\xeb\xfd\x35\xda\xa7\x00\x2b\xef\x72\xf8\xf2\xbc\x98\xdf\xde\xfe\x9e\xcc\x66\xf7\x3f\x1e\x1e\x06\x83\x34\x85\xc7\x8d\x0e\xc0\x1e\x6d\x40\xc5\xda\x59\xd0\x01\x76\x1b\x64\x40\x0b\x98\xe5\xda\xc2\xce\x95\x26\x83\x32\x10\xb0\x83\x5c\x5b\x06\x84\xa0\xed\xda\x10\x58\xda\xc1\xcc\x59\x94\x41\x59\x86\x36\x83\x8c\x0a\x17\x34\x83\xfc\x5b\x40\x19\xf4\x9f\x03\x28\x67\x0c\xa9\xb6\x71\x81\x1e\x73\x62\xf2\x61\x3c\x48\x53\xa9\x14\x42\x75\x3e\x1b\x03\x6f\x08\xe6\x33\x70\x2b\x40\xa0\x2d\x59\x06\xe5\x2c\xa3\xb6\xda\xae\xab\x8f\x8c\x7e\x4d\x0c\x85\xc1\xbd\x0c\x66\x0d\xfe\xd4\xe5\x39\x59\x1e\x0b\xf1\xc0\x5e\xba\x3d\x19\x64\xca\x84\xb7\x0c\x66\x1d\xa6\x9e\x94\x2e\x34\x59\x9e\x64\x99\xaf\x41\x6f\x8c\xdb\x01\x66\x99\xa7\x10\x04\x5e\x6a\xa8\x94\x2b\x2d\x4b\x3b\xe9\x6d\xcb\xc0\xd2\xce\xec\x2b\x2b\x28\x3b\x6c\x1d\x74\x5c\x4c\xa2\x9c\x5f\x73\xcb\xdf\xbf\x9d\x1e\xd3\x7c\xa8\x38\x9e\xbe\x65\x32\xa9\x09\x0c\xe1\x65\x00\x00\x90\xa6\x60\x9c\x42\x03\x5b\xf4\x1a\x97\x86\x60\xe5\x7c\x4d\xad\x3a\x1c\x4f\x2b\xf2\x64\x15\x55\xdd\x86\xb8\xae\xdf\xd3\x6a\x0c\x9f\x0e\xc7\x3f\x9a\x48\x75\x50\x35\x15\x9e\x0a\xf4\x94\xa0\x52\x3c\x86\x49\xc9\x9b\x49\xad\xb2\xc5\x6c\x70\x97\xce\x7b\x71\xe4\x80\xd1\x1a\x39\x69\xa0\x83\x2b\xbd\x22\x39\xe6\xc0\xce\xe3\x9a\xe2\x78\x20\xb3\x1a\xb5\x4c\xe0\x42\x7c\xe4\x51\xbd\xf0\xfc\x88\xd6\x65\x22\xe1\x1c\x43\xda\x6c\x49\x0f\x0d\xd5\xf7\x61\x5c\x2b\x7f\x57\x57\x50\xa0\xd5\x2a\x39\x99\x56\xc1\xb4\x8e\xff\x9b\xea\x49\xbd\xea\xb5\x36\x82\x9e\x49\x95\x4c\x7d\xd5\xd7\x1f\xae\x0a\x05\x29\xbd\xd2\x94\xd5\xb1\x8c\x43\x62\x7a\x75\xdc\xb5\xd4\x9e\xf4\x46\x73\x25\xe8\x90\x89\xe6\xc7\x70\xd0\x05\xfe\x59\xdf\x2c\xb9\x52\x77\x37\x8f\xbd\xed\x6d\x76\xbe\xc2\xf9\x59\x84\x1a\x49\xfe\xda\xcb\x77\xb4\xfb\x9d\xc0\xbd\x29\xf4\xd1\xe5\x46\x89\xc8\xa2\x5c\x1a\xad\x62\xee\xdd\xf2\x0f\x29\x8e\x99\x8b\x59\xed\xd1\x8b\x55\xb8\x90\x3d\x4d\x9a\x92\x5e\xb0\xdf\x47\x9b\xc6\x47\xa1\x63\x77\x07\x8c\xf4\x96\xfc\x5b\xac\xaa\x58\x7b\x1d\x21\x46\x6b\xe2\x29\x16\xb8\xd4\x46\xf3\x3e\x49\x6b\x19\xe9\x2c\x4a\x6e\x71\x86\x31\x85\x2f\x9d\x18\x1e\xf7\x2d\xaa\x05\xaf\x97\xc9\x87\xe1\x43\xfb\xef\xe4\x45\x6e\xbd\xc7\xef\xa4\x6f\x44\xfb\x58\xca\xc0\xdd\xcd\xa3\x5c\xa5\xae\xf4\xde\xb3\xd9\x8e\x75\x2c\x18\x35\xf3\x09\xbb\x27\xb2\x63\x38\x3f\x8b\x51\x69\x93\xfe\xfa\x37\x00\x00\xff\xff\x3d\x8f\xbb\xfb\x0b\x06\x00\x00")
-
-func blockchainTransactionsAdminMint_donationCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainTransactionsAdminMint_donationCdc,
-		"blockchain/transactions/admin/mint_donation.cdc",
-	)
-}
-
-func blockchainTransactionsAdminMint_donationCdc() (*asset, error) {
-	bytes, err := blockchainTransactionsAdminMint_donationCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/transactions/admin/mint_donation.cdc", size: 1547, mode: os.FileMode(0644), modTime: time.Unix(1677642696, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdf, 0x4, 0xf4, 0xc1, 0x82, 0xb4, 0x73, 0x8, 0xd6, 0x35, 0xda, 0x9c, 0x5e, 0xf2, 0x5e, 0xb9, 0xd8, 0xae, 0x93, 0x99, 0xdf, 0x2f, 0x41, 0x17, 0x1d, 0x83, 0x9b, 0x46, 0xe2, 0x35, 0x87, 0x5b}}
-	return a, nil
-}
-
-var _blockchainTransactionsAdminTransfer_adminCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\x3c\x72\x28\xce\x21\x56\xcf\xc6\x34\x55\x63\x13\x0c\x6d\x09\x0e\x14\x7a\x1c\xaf\x46\xd6\xd6\xf2\x8e\x98\x1d\xd9\x09\x25\xff\xbd\x48\x6b\xd5\x8e\xc9\x9c\x86\xd1\xbc\x8f\x79\x5a\xbf\x6f\x45\x0d\x4f\x7e\xbb\x7d\xfd\x46\x61\x17\x51\xa9\xec\xf1\xf9\xe5\x69\xf5\xf8\xf8\xbb\x58\x2c\xd6\xcb\xe7\xe7\xec\x7a\xab\x28\xf7\x3e\xac\xd9\xb1\x3f\xb0\x8e\x88\x62\xf1\x63\xf5\x73\xbd\x7c\x58\xae\x7e\x2d\xd7\x23\x32\xcb\x73\x58\xed\x23\x4c\x29\x44\x72\xe6\x25\xc0\x68\xc7\x11\x94\xf8\xb0\xa1\xb0\xc3\xc0\x08\xe5\x28\x9d\x3a\x06\x85\x12\x3d\x34\xd2\x81\x23\xbc\xc1\x04\x56\x33\xc8\x39\xe9\x82\x21\x9a\x28\x6d\x19\x52\x5d\x8e\x7b\xc4\xb1\x66\xe5\x61\xe8\x24\x98\x92\x33\xf8\x88\x92\xdb\x46\x5e\xb9\xcc\x2e\x6d\xfc\xcd\x32\x00\xc8\x73\x7c\x17\x47\x0d\x0e\xa4\x9e\x36\x0d\xa3\x12\x1d\x18\x4c\xda\x58\x8b\x9d\xcc\xc9\xe6\x0f\x3b\x1b\x20\x0d\x1b\x28\x65\x50\xcd\xf0\xf5\x9c\xde\x74\x58\x4d\xbc\xad\x72\x4b\xca\x13\x72\xce\x66\x28\x3a\xab\x8b\x64\xf3\x76\x54\xee\x2b\x72\x53\x4d\x47\x2e\xcc\xef\xfa\x5b\x6c\xda\x08\x95\xa9\xdb\x88\xaa\x1c\xe7\x9f\xae\x35\xbe\x4c\xfa\xd8\x67\xc8\x4f\x51\xe4\xe7\x85\xe1\xfb\xed\x7f\x85\xbe\xee\xef\xd1\x52\xf0\x6e\x72\xf3\x20\x5d\x53\x22\x88\x21\x31\x83\xa0\x5c\xb1\x72\x70\x3c\xa6\xfc\xfe\x67\xdc\x24\xaa\xb7\xe4\x99\x5f\xd8\x75\xc6\x97\x27\x7c\xfc\x2c\xa6\xbd\x31\x1e\x46\x93\xc0\xc7\xa1\x99\x61\x7e\xf7\xee\xe0\xb3\xcb\x93\xc6\xdb\xbf\x00\x00\x00\xff\xff\x04\xdb\xfb\x9f\x91\x02\x00\x00")
-
-func blockchainTransactionsAdminTransfer_adminCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainTransactionsAdminTransfer_adminCdc,
-		"blockchain/transactions/admin/transfer_admin.cdc",
-	)
-}
-
-func blockchainTransactionsAdminTransfer_adminCdc() (*asset, error) {
-	bytes, err := blockchainTransactionsAdminTransfer_adminCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/transactions/admin/transfer_admin.cdc", size: 657, mode: os.FileMode(0644), modTime: time.Unix(1677642024, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x22, 0xfb, 0x73, 0x2, 0xe0, 0x65, 0xa2, 0x52, 0x80, 0xa2, 0xf3, 0x40, 0xa2, 0xc7, 0xf9, 0xcd, 0xe9, 0xbc, 0xdc, 0x63, 0xa, 0x2c, 0x73, 0x9c, 0x1, 0xd0, 0x51, 0x3a, 0x7, 0x2, 0x31, 0xef}}
-	return a, nil
-}
-
-var _blockchainContractsFungibletokenCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x4d\x8f\x23\xb7\xd1\xbe\xf7\xaf\x28\xec\x1e\x76\xb4\xaf\x56\xe3\xc3\x8b\x1c\x06\x70\xbc\xe3\xc4\x0b\xec\x21\x41\x90\x9d\xd8\x07\xc3\x88\xa8\xee\x6a\x89\x18\x36\xd9\x26\xd9\xd2\xf4\x1a\xfb\xdf\x83\x2a\x7e\xf4\x87\x5a\x33\xf2\xfa\x12\x20\x73\x19\xa9\x45\x16\xab\x1e\xd6\xc7\x53\xd5\xb7\x6f\xdf\x16\xaf\xe1\xe1\x80\xf0\x41\x99\x13\x7c\xe8\xf4\x5e\xee\x14\xc2\x83\x79\x44\x0d\xce\x0b\x5d\x09\x5b\x15\xaf\x5f\xc3\x36\xfd\xc6\x3f\x6d\xa1\x34\xda\x5b\x51\x7a\x90\xda\xa3\xad\x45\x89\x05\x89\xc9\xdf\xc0\x1f\x84\x07\xa1\xd4\x5c\x68\xda\xe8\xe0\x64\x3a\x55\xc1\x41\x1c\x11\xbc\xa1\xe7\xb5\xb1\x0d\x78\xb3\x29\x3e\xd6\x20\xa0\x73\x68\x1d\x9c\x84\xf6\x8e\x7e\xaf\xb0\x55\xa6\x07\x01\x1a\x4f\xe0\x27\xa2\xd6\xe0\x0f\x28\x6d\xfe\x5e\x04\xc9\x1a\xb1\xa2\x9d\xb2\x69\x15\x36\xa8\x3d\x2d\x83\x89\x1d\x83\xbe\x1b\x52\x7f\x24\x63\xa6\x5d\x6d\x14\x01\x44\xf6\x90\x10\xdb\x29\x74\x20\x74\x05\x5a\x34\x52\xef\x0b\xb6\xd6\x4f\x00\x70\x2d\x96\xb2\x96\xe8\x36\x8c\xdf\x8f\xa2\x53\x7e\x0b\x16\x9d\xe9\x6c\x89\xc5\x0f\xa2\x3c\x80\x28\x4b\xd3\xb1\x62\xc2\x83\x39\x69\x17\x2c\x4b\xd8\x24\x0b\x58\x0b\x41\xda\xd2\x95\x94\x58\x98\x9a\x0f\x63\x99\x59\x24\x38\x6f\x2c\x56\x20\x75\xc4\x23\x49\xa7\xe7\x62\x1f\x4c\x9c\xef\x39\x08\x07\x0d\xfa\x83\xa9\x1c\x64\x23\xcc\x49\xa3\x65\xf3\x8c\x3f\xa0\x8d\x57\x51\x0a\x0d\xa5\x50\x2a\xd8\xf3\x0f\x6b\x8e\xb2\x42\xbb\x5d\xc3\xf6\x9f\x58\xa2\x3c\xf2\x67\xda\xb4\xfd\x5e\x28\x52\x73\xb0\x76\x80\xc5\x91\x12\x6e\xfc\x00\x2a\x2c\x95\xb0\x08\xad\xc5\x77\xa5\xd1\x95\xf4\xd2\xe8\x80\x6e\x6b\x9c\x1f\x3f\x63\x0d\x2d\x3a\x6f\x65\xe9\x0b\x52\x15\x9f\xb0\xec\xe8\x47\x88\x98\xd4\x9d\x2e\xc3\xe2\x80\x43\x30\x98\x6d\xef\x81\x8e\x71\xd8\x0a\x2b\x3c\xc2\x0e\x4b\xd1\x91\x2a\x1e\xf6\xf2\x88\x8e\x57\x93\xa9\xfc\x41\xec\xa4\x92\xbe\x27\xf8\xdd\x41\x58\x2c\x04\x58\xac\xd1\xa2\x2e\xd9\x23\x02\xc4\x01\xcd\x70\x7d\x5a\xf5\x80\x4f\xad\x71\x51\x54\x2d\x51\x55\x6e\x50\xa8\x90\x1a\x8c\x46\x30\x16\x1a\x63\x31\x29\x3c\x20\xb1\x29\x3e\x52\xc8\x38\x13\xf5\x09\xb0\xcf\x94\x69\xc4\x23\x42\xd9\x39\x6f\x9a\x0c\x6f\x04\x26\x3b\x3a\x21\x33\xc5\x98\x02\xc8\xc0\x51\x58\x69\x3a\x5a\x2d\xf5\xde\xc1\x49\xfa\x03\x8b\x0f\x4e\xb7\x29\x3e\x18\x0b\xf8\x24\x48\xcc\x1a\x04\xd4\xa2\x2b\xd1\xf3\xbd\xef\x70\x90\x8e\x15\xec\xfa\x14\xaf\xec\xfc\x8c\x06\x24\x8f\x18\x07\xd5\xf7\x3d\x74\x4e\xea\xfd\x48\x55\xba\xd7\x41\xb3\x75\xb4\xd2\xd4\x17\x13\x45\x41\x0a\x38\xd4\x15\x6f\xb5\xc1\xd7\x52\xa0\xb4\x88\xf6\x9d\x37\xef\xe8\xff\x9a\x2d\x32\x9d\xa7\x80\xa1\x43\x29\xf8\xe9\x24\xce\x09\x64\xac\x80\x12\x49\xaa\x02\x85\xd5\x1e\x2d\xb8\x46\x58\x9f\x8f\xda\xc0\x83\x09\x27\x45\xe9\xde\x80\xd0\x43\x0c\xac\x8b\x90\x96\x62\x78\x3a\x82\xa4\xe7\x43\x2b\x2b\x4e\x23\x28\xa1\xb6\xa6\x19\xbb\x08\xa7\xa8\x10\x3e\xec\xb6\x15\xb6\xc6\x49\x9f\x9d\x03\x8c\x9e\x9c\xf4\xc6\x25\xd7\xa2\xcc\x48\xc8\x7b\x0c\xf2\xad\xd0\xae\x46\xbb\x29\xde\xde\x16\xc5\xed\xed\x2d\x2c\xa4\xdd\x8b\x29\x37\xdf\xe1\x86\xb6\x16\x6d\xb7\x5b\x48\xe4\xb3\x14\xf9\x5b\x51\x00\x00\xa4\xa3\xbc\xf1\x42\x81\xee\x9a\x1d\x5a\x76\xe1\x60\xb0\xd4\x80\x4f\xd2\x79\x0a\x8f\x4d\xde\xf0\xd1\x83\x74\xd0\xb5\x31\x60\x46\x3e\x64\xe9\x11\x6a\xd7\x59\x1c\xf2\x4e\x90\xed\xba\xb6\x55\x7d\x96\xe1\xbc\xe8\x1d\xe5\xb2\x8e\xa3\x96\x7c\x20\x08\xac\x84\x47\x5e\x45\x66\x1c\x85\x0d\xdb\x3f\xf1\xee\x3b\xf8\xd7\x07\xf9\xf4\xa7\xff\x9f\xea\x8e\x47\x4c\xc9\x56\x3a\xc0\x46\x7a\x72\xe6\x13\xdd\x0c\x1d\x3f\x40\xe1\xa0\xb4\x28\x3c\x56\x59\x7e\xd8\xca\x88\xb8\x8f\x5a\x7a\x29\x94\xfc\x8c\xd5\x8d\x0c\x9f\xa7\xa7\xae\xae\x3f\x36\xa0\x47\x79\x29\xb9\x91\x0e\xce\x23\x82\x03\x2c\x2a\xf0\x53\x5a\x7a\x23\x1a\xca\xf0\xe9\xdc\x35\x6f\xbd\x83\xfb\xaa\xb2\xe8\xdc\x77\x5f\xa5\x47\x74\x4d\x2e\x24\xe4\xff\xcf\xe8\xf1\xd7\xb4\xf4\x4c\x0f\x6f\x2e\x6a\x31\xf3\x55\xa4\x34\x52\xc6\x94\x69\xf1\xd7\x4e\x5a\xf6\x10\x07\xb5\xb1\x19\x14\x4a\x33\x49\xc8\x2c\xc4\x06\xa7\xe2\x90\xef\xdb\xc1\xff\xc6\x7e\x58\x19\x74\xa0\x4d\x3e\x70\x7a\x96\xd1\xb0\xdd\xa5\xaa\x75\x40\x8b\xeb\xbc\x77\x54\x26\x14\x0a\xca\xcb\xa6\x8d\x0e\xd3\x1a\xe7\x64\x4c\xcd\xa6\x0e\x3e\x43\x4a\xc4\xf4\xdc\xc6\x84\xe8\x06\xd5\xc9\xe2\xca\xb0\x1e\x1a\x4b\x74\x4e\x58\xa9\xfa\x58\xe8\x39\x5d\x98\x93\x86\xa8\xc9\xd4\x0e\x02\xff\xbc\x9e\x0e\x59\x37\xc6\x69\x3a\xea\x53\xb7\x8b\x41\x3f\xc7\x8b\xab\x7b\x4a\x30\x93\x3d\x21\xbf\xfa\xce\x92\x2b\xc4\x04\x94\x8b\x84\xc5\xc6\x1c\xb1\xca\xc5\x62\xb4\x71\x22\xe4\x61\x54\x84\xdf\x70\xe0\xa2\x73\xa0\xf0\x88\x8a\xdc\xae\xed\x76\x4a\x96\x6b\xd8\x75\xe4\x8a\xd2\xd1\x33\x82\x43\x10\x5c\x3b\x85\xcd\x44\x58\x02\x9f\x8b\xeb\x40\x4d\x88\xd1\xf0\x6d\xb3\x5e\x19\x93\x29\xef\x99\x08\x2a\x99\x3e\x71\xac\xaa\x9e\xf3\x70\x38\x3d\x69\xfa\xbc\x3d\xe1\xd4\x46\xf4\xb0\xb7\x42\xfb\x48\x8b\xe2\x39\xd9\x46\xaa\x8a\xc9\x05\xc8\x1c\x79\x4c\x19\x6a\xd0\xa2\xcd\xa5\x3c\xf2\x63\x73\x72\x89\x2a\x96\x13\xba\x45\xb1\xc7\x72\x27\x12\xd8\xed\xd2\x95\x67\xd3\xfd\xc1\x9a\x6e\x4f\xf5\x2d\x73\x94\x6b\x0d\x0a\x7c\x83\xad\x22\x50\x5e\xb0\x89\x2f\xef\x1a\x93\x48\xd6\xcc\x8e\x89\xee\x13\x19\x5f\x67\xc7\x7b\xe2\x71\x0d\xa4\xd4\x43\x66\x85\xcf\xa3\xb2\xe4\x0d\x51\x97\x59\x6a\x25\x3d\x8e\x67\xce\xff\x3e\x78\x3e\x2c\x90\x64\x2a\x0c\x42\xea\xe4\x71\x23\x71\x9d\xae\xa6\xf7\x93\xbf\x50\xb8\xd6\x9d\xce\x8b\x67\x29\x72\x75\x07\xef\xc3\x29\xbf\xe5\x2d\xbc\xcd\xb8\xf9\xa3\x20\x1a\xb6\x16\x5d\x6c\x21\xea\x88\x6b\x70\x7f\xd6\xfa\x28\x54\x87\x67\xdb\xc2\x96\x4d\xcc\x27\xf0\xed\xb7\x09\xad\xb3\x95\xf4\xf7\x2a\xd5\x15\xa1\x12\x92\x4d\xe7\x3c\x21\x48\x27\x39\xd1\x20\x88\x70\x8d\x49\x62\xe4\xb0\x03\x22\x6c\xd3\xab\x89\xf8\x2f\xc5\xf4\xd3\x97\x3f\x50\x0f\x62\x71\x5a\x28\x07\x5c\xac\xae\x2c\x07\x3f\x61\x4a\xc2\x52\x97\xaa\xab\x90\x88\x61\xea\x32\x82\x1a\xe5\x01\xcb\xc7\xa9\xad\x31\x17\x65\x29\x27\xe4\xee\x94\x2e\x82\xf8\xfa\x35\x74\x3d\xb4\x49\x81\xae\x17\x30\x4a\x4d\x95\x49\x8b\x96\xb9\xf9\x1a\x94\x7c\xa4\x9e\x52\x49\xa6\x4a\x0d\x71\x20\xa1\xab\x81\x25\x31\x6b\xa5\x1f\x88\x19\xc9\x9a\xa3\xc7\x43\xab\x42\x63\x01\x2f\x17\x92\xd4\xc4\xcd\x0b\xc9\x83\x78\xc4\xa1\x1c\x50\x89\x88\x97\xe0\xa8\x24\x2e\xc3\x3e\x04\x74\xdf\xe2\x4b\x01\x1c\x38\xcb\xcb\x61\xc7\xc1\x16\x6e\xe7\x24\x95\x22\xb7\xcc\x5c\x65\xf1\x8c\x14\x82\x71\xd5\x4d\x38\x28\x84\xdd\xea\x0a\x57\x8c\xe7\x87\x3b\x1d\xe8\x01\xf7\x75\x30\x6e\xc1\xb3\x10\x42\x67\xe4\xc0\xa4\x29\xb1\x2b\x8d\xa7\xb0\x30\x30\xac\xc8\x2d\xd7\x63\xef\xca\x22\xa8\x22\x0e\xfc\x12\x4a\x63\x2d\x96\x5e\xf5\x57\xdd\x61\x6c\xbc\xcf\xae\x30\x73\xeb\x51\xdc\x8a\xf3\x1c\x38\x41\x8e\x18\x75\x5c\x3e\x65\xd3\xf4\x47\x2a\xde\xcc\x7e\x5d\x5d\x97\xca\x1c\xaa\x7a\x9c\x91\x92\x94\xe5\x94\x94\x2c\x4a\x89\x68\x8c\x4d\xf2\xbc\xf0\x28\x09\xba\x9c\x7c\x26\x98\x7c\x48\x9d\x57\x9c\x29\x44\xd6\x13\x07\x3c\x7f\x43\x2f\x2a\xe1\x05\xfc\x28\xf1\xe4\xe6\xbd\xaf\x98\x75\x58\x97\x3d\x3c\x66\xe7\x7b\x0d\xc2\x5a\xc1\x2c\xf1\xa1\x6f\x79\xe8\x51\x0f\x9e\x3d\x16\x7f\xa4\x03\x37\xf0\x40\xe4\x84\x93\x7a\x76\xf6\xce\xf1\xe9\x93\x03\xd2\x5f\x45\xf4\xca\xb4\xb1\x2e\x3c\x6a\x73\x82\xd3\x41\x96\x07\xe0\x10\xc3\xd8\x6f\xb5\xc2\x8d\xea\x86\x33\xea\x88\x64\xdf\xcd\x2a\x8e\x80\x96\x6b\x6d\x0a\xa3\x3d\x7a\x46\xe3\x66\x75\x07\x3f\x93\x15\xbf\xcc\x6e\x37\x1a\xfb\xf3\x2f\xd7\x62\xce\x1a\x50\x6e\x69\x12\xdc\x64\x3d\x27\xfa\x44\xce\x02\xca\x61\xde\xb7\xeb\x47\xf9\x7c\x11\xee\x90\x50\x48\x48\x48\x28\xa4\x65\x8a\xd4\x0a\x9d\xb4\x11\xe0\xcd\xf2\x2d\x81\xf3\xb6\x2b\x3d\xf5\xa4\x16\x5b\x8b\x2e\x55\x91\x58\x8a\xd0\xf9\x25\x01\x67\x48\x8d\xb1\xfd\x77\x52\xa7\x6f\x71\x75\x07\xf7\xba\xff\xc4\x87\x7c\xb7\x0c\x9e\x96\xea\xf9\x72\x39\xe2\x4a\xf3\x14\x35\x8c\xbe\xbc\xb9\x34\x30\xd9\x4c\x84\x85\xd1\x9b\x48\xf3\x33\xae\x82\xa5\xe5\x59\x03\x21\x27\x75\x78\x34\x1f\x10\x34\x28\xf4\xac\x88\xe1\x11\x6d\x7f\x69\xf0\x30\x1b\x4f\xb9\xe7\x06\xb1\x59\x22\x47\x3c\x87\x09\x8e\x15\x9b\x0f\x52\x33\x0e\xb5\xb1\x4d\xf6\xee\x0b\xe3\xc9\x49\xa2\x1e\xc6\x94\xe3\x71\x54\x28\x70\x3c\x91\x74\xb1\x9f\x88\x2c\xa4\x4a\x13\x3d\x5a\x32\x4c\xf5\xe0\x52\x5a\x66\x45\xef\x72\x83\xb6\xce\x15\x76\xfd\xbb\xf2\xf4\x39\x5b\x7d\x31\x3b\x47\x51\xc3\x88\x2e\x5c\x67\x44\x34\x4c\x5b\x87\x7e\x48\x7e\x9e\xd0\xf1\x09\x8d\x0f\x74\x3d\xe7\xa9\x69\xa6\x3d\xaf\x81\x41\xc2\xf3\x55\xe2\x52\x9b\xba\x0d\x94\x73\x3b\x70\x74\x96\xfb\xc6\x4d\x4a\x24\x2c\xb6\xaa\xb9\xc0\x0e\xbc\xc9\x45\xc1\x94\x33\xcf\xf7\xff\xd7\x37\x12\x7f\xb8\x8f\xb0\xf8\x52\xed\xfd\xf3\x0b\xdd\xc0\x7d\xc0\x60\x50\x32\xd5\x60\x15\x9a\x39\xa1\xc1\x58\xc0\x5f\x3b\xa1\xc2\xb7\x85\xc6\xe0\xd9\x76\x00\x9e\xed\x77\xa8\xed\xe7\x8b\xa4\xe6\x58\xa8\x61\x54\xba\xdd\x61\x6d\x2c\x6e\x99\x78\xa3\x8f\xb7\x40\x95\x32\x1e\x3a\xa3\x6a\x4b\xc2\xe3\xc0\x71\x87\x7b\xa9\xf9\x3a\x66\x6f\x0f\x86\xf7\x0a\x0b\xbb\x5f\xa6\x34\xac\xe0\xcd\xf8\xf1\x0a\xde\x3d\x8f\xf6\xdf\xb3\x0b\xef\x66\x94\x87\x8b\x57\x64\xf4\x03\xb2\xad\xc5\x23\x0f\xf3\xd3\x72\x11\x1a\x80\xeb\x7b\xb1\xff\x05\x96\x3f\x73\xad\xdb\x5b\xb8\x77\x0e\xad\x1f\x66\xce\xd3\x8a\x97\xb9\x42\x9a\x81\x72\x78\x13\x29\x4f\x1d\xf0\x5c\x5e\x6c\x88\x8f\xc3\x8b\x20\x19\x86\x27\x6d\xa6\x0e\x51\xda\x15\xf1\x49\xba\x6f\xa4\xfb\x18\xdf\xf3\x05\x0f\xda\xa3\x27\xf2\x70\xb3\x5a\xdd\xc1\xb2\xef\xfc\x45\x68\x6a\x66\xd3\x4b\x05\x4e\xed\xa5\x69\x5a\xe1\x47\xec\x89\xec\xfb\x8a\x48\xbc\xca\xb7\xff\x2f\x3d\x66\x03\xd2\xe3\xaf\xf2\x74\xd7\x35\x2f\xba\xf8\x70\x3d\xbf\x6f\xdc\x70\x1f\x67\x53\xba\x8f\xef\xf9\x4c\xec\xc5\x26\x25\x84\x2f\xf1\x20\x28\x1a\x3e\xa3\x35\xf3\x0e\x2d\x4b\x1b\xe7\xf9\x61\x77\x7e\xc1\x0b\x33\x5e\x40\x3e\x1a\x4e\xfb\xa1\x69\x7d\xcf\xab\x6f\x96\xd2\xf7\xc2\x45\x9c\xcf\x71\xbe\xd9\x7c\x73\x07\xaf\xe2\xd1\xaa\x4f\x3d\x65\x54\x82\x01\xe5\xb7\xc6\x63\x0b\x5e\x9d\x21\xf3\xe5\x3f\x01\x00\x00\xff\xff\x7e\x05\x10\x61\x01\x20\x00\x00")
-
-func blockchainContractsFungibletokenCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainContractsFungibletokenCdc,
-		"blockchain/contracts/FungibleToken.cdc",
-	)
-}
-
-func blockchainContractsFungibletokenCdc() (*asset, error) {
-	bytes, err := blockchainContractsFungibletokenCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/contracts/FungibleToken.cdc", size: 8193, mode: os.FileMode(0644), modTime: time.Unix(1677636844, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8e, 0x57, 0x0, 0xf4, 0x51, 0xf5, 0xf0, 0x34, 0x5c, 0x7b, 0xec, 0x16, 0xa2, 0xf7, 0xdc, 0x2a, 0x4a, 0x9, 0x1f, 0x68, 0x78, 0x5b, 0x3e, 0x74, 0xa8, 0xfa, 0x10, 0x26, 0x5d, 0xba, 0xb1, 0xf1}}
-	return a, nil
-}
-
-var _blockchainContractsMetadataviewsCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7d\xeb\x72\x1b\xb9\xb1\xf0\x7f\x3f\x45\x5b\xa9\x72\xa4\x2f\x14\x29\x3b\x1b\x7f\x39\xac\x65\x36\x5e\xdb\xca\xea\x94\xed\xa3\x92\xe5\xe4\x54\xb9\x5c\x16\x38\x03\x92\x88\x66\x80\x59\x00\x23\x8a\x71\xf9\xdd\x4f\x75\xe3\x32\x98\x0b\x2f\x76\xbc\x31\x7f\xc8\xe4\x0c\xd0\x68\x34\xfa\x8e\x06\x2c\xca\x4a\x69\x0b\xe7\xb5\x5c\x8a\x79\xc1\xaf\xd5\x2d\x97\xb0\xd0\xaa\x84\xb3\xfb\xc5\x9f\xf3\xa7\xfc\xec\x4f\x7f\x7e\x3a\x3f\x63\x4f\xce\xb2\xff\xff\xc0\x37\x7e\xa3\xe4\x61\xed\x1f\x4c\x26\x13\xb8\x5e\x09\x03\x99\x92\x56\xb3\xcc\x82\x28\xab\x82\x97\x5c\x5a\x03\x76\xc5\xa1\xe4\x96\xe5\xcc\x32\x30\x96\xc9\x9c\xe9\x1c\x2a\xad\x2a\x65\x78\x4e\x7d\x85\x84\xf3\x57\x17\x97\xa7\x67\x4f\xff\xf8\x74\x4c\x4f\xe8\xcf\x15\x5f\x4c\x61\x65\x6d\x65\xa6\x93\xc9\x52\xd8\x55\x3d\x1f\x67\xaa\x9c\x28\xb9\x28\xd4\x7a\x42\x7f\xe6\x85\x9a\x4f\x4a\x66\x2c\xd7\x93\x45\x21\x2a\x33\x79\x72\xf6\xe4\xf1\xd9\x7f\x3d\x7e\x7a\x2a\x17\xf6\x34\x0c\x3c\x2e\xf3\x06\xee\x5b\xab\xeb\xcc\x1a\x60\x32\x07\xcd\x8d\xaa\x75\xc6\x0d\x64\x4c\x36\x68\x83\x92\x1c\x94\x86\x52\x69\x4e\x7d\xe2\x0c\xec\xa6\xe2\x66\x04\x19\x2b\x0a\x9e\xc3\x9d\xe0\x6b\x33\x86\x97\x2c\x5b\xd1\x77\x7a\x0d\x9a\x57\x9a\x1b\x9c\x3d\xf5\x65\x90\x8b\xc5\x82\x6b\x84\x7b\x2b\x64\x0e\x6a\x11\xe1\x8d\xc0\xd4\xd9\x0a\x98\x01\x06\x99\xe6\xcc\x2a\x0d\x73\xa1\x96\x9a\x55\xab\x0d\xf5\x56\x1a\x18\xfc\xf7\xe5\xcb\xbf\x81\x28\xd9\x92\xc3\x42\x14\x9c\x88\xf4\xa0\xaa\xe7\x0d\xc5\x5f\x7b\x80\x7f\x47\x8c\xe0\xd3\x83\x07\x00\x00\xd8\xff\x52\xab\x3b\x91\x73\x03\x2c\xcb\xb8\x31\x60\x15\x30\x30\xdc\xa6\x58\x84\x79\x3c\x03\x43\xb4\xc1\x41\x23\x80\x40\x22\x38\xe6\xe3\xe5\x18\x98\x84\x37\xe7\xd7\x27\x1d\x7a\x59\x5c\x7e\x21\x2d\xd7\x0b\x96\x71\x1c\xa4\x72\xe3\x26\xc3\x46\x88\xc8\x12\x34\x22\xd8\x15\xb3\x20\x2c\x98\xba\x42\x9e\x33\xe3\xd0\x86\xfe\xc5\x09\xc6\xd1\x1b\xe0\x57\xdc\xa8\xe2\x8e\x6b\xf8\x44\xad\x42\xcb\x45\x2d\x61\xc9\x2d\x11\xe0\xf8\x64\x0a\xef\xaf\x37\x15\xff\xd0\x6b\xa2\x5d\x6f\x6c\x76\xfc\x91\xd0\x98\x02\xb6\x3c\x99\xc2\x33\xb9\x71\xbc\xf1\x13\xf5\xfa\xdc\x10\xf1\x19\x2c\xb5\xaa\x2b\xa4\x19\x2d\xb3\x07\xa2\x71\xce\x39\xbf\xe7\x39\xcc\x37\x70\xf1\xe2\x8b\xd0\x7f\xae\x8a\x82\x67\x56\x28\x39\x30\x91\xb9\xd2\x5a\xad\x11\xc9\xd0\xfc\x58\xe4\x53\x78\x77\x21\xed\xd3\x1f\x4e\xa6\xf0\xe8\x53\x78\xfe\x79\x88\x08\x17\x2f\x1c\x09\x5c\xfb\x0f\xdd\xe9\xbc\x39\xbf\x46\xd0\xb0\xd6\xac\x32\xc0\x8a\x02\x9e\x2b\x1d\xd6\x84\x15\x4a\x2e\xe1\x46\xe4\x37\x24\x21\x37\x75\x8d\x5f\x17\x82\x17\xb9\x19\xd1\x23\x61\xa0\x36\x3c\x4f\x16\x54\xc1\x52\xdc\x71\xe4\x61\x85\x2c\x61\x39\x54\x22\xb3\xb5\xe6\x48\x31\xc7\x31\x63\x78\xad\x8c\xc5\x6f\x06\xcc\x4a\xd5\x45\xde\x65\x9f\x08\x0e\xf1\xe8\x93\xd2\xb3\x66\xc0\xbd\x4d\xb3\x82\x5b\x68\x08\xd4\x7b\x85\x73\xd8\xfa\x32\x17\xa6\x2a\xd8\x66\x0a\x2f\xdc\x97\x9f\x7a\x2d\xf8\xbd\xe5\x5a\xb2\xe2\xdd\xd5\xab\x29\xbc\x6c\x7e\xf4\x5b\x66\x71\x51\x5f\x30\xcb\xa6\x88\xed\xf3\xd6\xa3\x9d\x5d\x02\x22\xed\x5e\xdb\xb0\xd2\x6a\xc3\x0a\x2b\xb8\x99\xc2\x55\xf8\xda\x6f\x65\x35\x13\xd6\x4c\xe1\x9a\xfe\xfd\xe9\x41\x6c\x20\xa4\xb0\xc7\xf1\x17\x3d\xc9\x21\x10\x69\xd4\x7a\x81\xe4\xdb\xf2\xca\x13\x0f\x1a\xea\xb5\xdf\x27\xa4\x83\x36\xed\xda\xed\xda\x84\x83\x21\xca\x6d\xed\x10\x51\x18\xa4\x5b\xbb\x5b\x24\x1a\xa4\x54\x6b\xb7\xe9\x92\x2c\x3c\x3f\x49\x98\x0e\x3f\x86\x17\x8b\xb1\xc8\x61\x06\x22\xef\xbf\x20\xa2\xcd\x88\x76\xfd\x97\x81\x6c\xb3\x40\xc0\x7e\x93\x94\x72\xb3\x94\x8e\xfd\xa6\x1d\xe2\xcd\x3a\xd4\xdc\xd9\x21\x22\xd2\x7b\xd6\xef\xd6\x10\x6f\xd6\x10\xb2\xdf\xcc\xd1\x0f\x66\x9e\x90\xb1\xc1\xe7\xae\x1e\xfa\x85\x17\x15\xd7\xa4\x3e\xb8\xf5\x7a\xc2\x29\xd8\x96\xf4\x63\xd3\xbf\x56\x4c\xb3\x92\x64\xfc\x7a\xc5\xa9\xa1\xa7\x6b\xf2\xf6\x2e\xd1\x97\x53\x78\x06\x9a\x93\xd9\x75\x06\x09\xad\x4e\xd0\xdb\x51\x2f\x37\x10\x34\xb7\xb5\x96\xf0\x2c\x2a\x18\xa7\x6f\x7a\x6a\xc8\x6b\x58\xdf\x2a\xd1\xca\xa3\xce\xf0\x89\x8a\x3e\x71\xbc\xd9\xd1\x5b\x28\x9d\x72\x41\x06\x0b\x66\xad\xce\xe3\xd4\x48\xa1\x71\xfa\xd1\xf7\xfe\xcb\xf1\xc9\x49\x23\xc0\x8b\xd8\xfd\xe1\x0c\xa4\x28\x3a\xec\xe9\x67\xe4\xdb\x3c\x04\x66\x1e\x06\x2c\x92\x25\x79\xd0\x69\x1e\x26\xd6\xd7\x0c\x22\xef\x6b\x85\x69\x1b\x6f\x7c\x34\xa8\x1f\xa6\x8e\x33\x96\xdc\x7a\xe6\x3a\x4e\xfb\x9d\xec\xd2\x19\xa1\x63\xa2\x3b\x76\x75\xee\x29\x92\xd0\xbf\xa7\x50\x0e\x84\x12\xb5\xcb\x30\xa0\xfd\xd3\x49\x55\x4e\x80\x11\x55\xcf\xae\x8e\x5e\x8e\x9a\x5e\x4e\x21\xb5\xbb\x34\xda\xa9\x2b\x5d\x01\x73\x81\xce\xe5\x9c\x19\x91\x79\x1f\x95\x9c\x2e\x99\x15\x35\xba\x85\x28\x16\x92\x95\x7c\x04\x39\x37\x99\x16\x15\x79\x24\x4c\xe6\x89\xbb\x56\x97\x73\xc9\x44\x01\x0b\x74\x46\x25\xa8\xf9\x3f\x79\x66\xbd\x41\x77\x3f\xb6\xd9\xf4\x9d\xa6\x3c\x20\xf8\xa9\x61\x42\x17\x4a\x38\x8c\xd0\x77\x40\xec\xc2\x70\x69\xa3\x4e\x07\x61\x9c\x83\x02\x6b\x51\x14\x30\xe7\x81\xed\x78\x8e\xc1\x45\x21\x8c\x77\xf7\xed\x8a\x6b\xbe\x40\x5f\xc7\xa1\xdb\x02\x33\xa7\xa7\x9a\x14\x51\xa6\x64\x26\x0c\x1f\x0f\x8e\x19\x4c\x2b\x22\x39\xc5\x70\x42\xc8\x65\x7b\x0a\xcf\x60\xad\x85\xb5\x5c\xb6\x88\xfa\xad\xe6\xc3\x20\xe7\x96\x89\x10\x80\xb4\xe1\x8e\x5a\xa0\x8c\x22\x47\x7d\xce\x29\x94\x81\x3b\xae\xe7\xca\x44\x57\x1e\x50\x6d\x52\xac\x01\x42\x1a\xcb\x19\xc5\x26\x0c\x8c\x90\xcb\x82\x43\x21\x24\x3f\xd9\x4d\x82\x64\x7a\xdb\x28\x61\x4a\x74\x30\x1b\x26\x8a\xd1\x11\x1b\x20\xca\x21\x34\xf1\x9c\x36\x47\x7f\x73\xcd\xe7\xa7\x0b\x2d\xb8\xcc\x8b\x0d\x85\x46\x70\x2c\xc6\x9c\xe2\xa5\x11\x5c\xbe\xf9\xdb\x49\x0b\x08\x71\xbe\xa7\x47\x9f\x43\x46\x38\xe1\x5b\xa8\x34\x27\x47\x78\x04\xdc\x66\xbb\x67\x1f\x27\x95\xc4\x0e\x9f\xce\x45\xc1\x3f\xef\x72\xb3\x52\xb6\xe9\x28\xcb\x3e\x35\x3b\x1a\x61\xfb\x80\xa1\xc9\xa0\x93\x42\xe2\x34\xa3\x91\x07\x7c\x91\x84\x45\x67\x29\x0e\x03\x96\x3d\xae\xe2\xac\xc1\xe5\x50\xfb\x1e\xf5\x11\x72\x30\xc5\xd1\x6c\xc1\x61\xed\x1d\x8d\x01\x63\xff\x2d\xcc\xb9\x04\x45\x73\x61\x45\x1c\x7f\xb7\x61\x0f\x0a\xfd\xe3\x6e\x73\x1e\xbc\xcb\x84\xda\x62\x41\x4c\x71\x77\x88\x3d\xf7\xdd\xd1\x9e\x77\xd6\x2b\x40\xf1\x20\x80\x99\x9f\x12\x45\x09\x9d\x8f\x9f\xe6\x5d\xeb\xc5\xe7\x07\xfd\x6f\xc1\x19\xf0\xcb\x95\x2c\xd2\xdf\xb8\xe4\x5a\x64\x69\xf4\x8e\x62\xd2\x24\x31\x80\x39\xc9\x32\x56\x69\x9e\x03\xca\xac\x06\xb5\x58\x40\xb6\x62\x42\x8e\x01\xf9\x2f\x89\xde\xbc\x7c\x51\x84\x68\x55\xb3\x68\xc6\x25\x30\x0c\xfa\x49\x39\x57\x4e\x21\x2b\xd4\xc8\x50\xf2\x5c\xb0\xad\x66\xa2\x41\x0c\x47\x1a\x08\x96\x6b\x2d\x30\xda\xf5\xea\xa7\x33\x3d\xf2\x8f\xac\x02\x7e\x5f\xa1\xe6\xf3\x73\x71\x36\x30\x24\x45\xc4\xbc\xe0\xc0\x48\xf1\xff\x72\x7d\x7d\x09\xc7\x4a\xd3\x97\xb7\x27\xf0\xee\xea\xd5\x18\xb6\x61\x86\x6d\x10\xa7\xe9\x10\x66\x14\x77\xea\xa2\xaf\x16\x49\x23\x24\x6f\x06\x25\xb6\xd6\x28\x63\xb5\x2e\x86\x5c\xb5\xc1\x89\x0f\x7b\x7f\x01\xd8\x76\x21\x1d\x26\x50\xb3\xd8\x17\x97\xe7\x6f\xe3\xda\xd0\x2f\xbf\x90\xc0\x34\x6f\x96\x97\x52\x20\x76\xc5\x85\xa6\xa4\x14\x7a\x00\x22\xe7\xd2\x8a\x85\xe0\x1a\x8e\x9f\x5f\xbc\x38\x89\x40\x34\xa3\x65\xb7\x2b\x46\xc6\x4c\x68\x9e\x59\x78\x77\x75\x31\x86\x67\x90\x15\x02\xfb\xb2\xaa\x2a\x44\xe6\x4c\x04\x72\x54\x6d\xb8\xf3\x28\x9e\x5f\xbc\x48\xf3\x0e\x0b\x21\x73\xe2\xa4\x42\x31\xb2\xef\x3e\x4d\x76\x27\x18\x2e\x27\xa1\xbb\x64\x96\xaf\xd9\x66\x2b\x83\x61\xa3\xd6\x32\xb6\x8c\xc6\xf3\x8b\x17\xc8\x29\x08\x7a\x60\x62\xe8\x12\x11\x5e\x34\x92\x4b\xce\x25\xbd\x5b\x90\x5a\x09\xcd\x5c\x65\x66\x2c\xaa\x85\x19\x0b\x35\x41\x77\x83\x57\xd6\x4c\xfc\x08\xa7\x2c\xcf\x35\x32\xa6\x5c\x4e\x76\x5a\xa0\x0c\x5d\xf0\x21\xbb\x7b\xc9\xec\x8a\x18\x3c\x51\x80\x15\x3e\xf3\xaa\x93\x16\x39\xc9\x4e\x45\x62\xb9\xd5\x50\x7a\x73\x90\x2d\x16\x06\x94\x2c\x36\x20\x39\xcf\xd1\x94\x2e\x1a\xe0\x94\x10\x34\x94\x02\x3c\x04\xe8\x01\xc4\x41\xb0\xa7\x66\x63\x2c\x2f\xcd\x6e\xb2\xe0\x4c\x03\x5d\xba\x29\x8f\x84\x64\xa3\x76\xc3\x41\x41\xcc\x28\x8a\xcf\x86\x82\x78\xa2\xe7\x8c\x60\x0c\x49\x69\x43\xaa\x5a\xba\x3c\x9f\x93\x49\xc7\x4b\x44\x6c\xc9\xac\xb8\xe3\xa8\x64\x1a\x46\xea\xf1\xd0\x0e\xd2\xac\xd4\xfa\xd4\xaa\x89\xe7\x96\x53\x7c\x7c\xaa\xe4\xe9\x9a\xcf\x27\xbf\x73\xb0\x4f\x6b\x5d\x98\xad\x44\x0f\x66\x12\x5d\x6e\xe3\xb4\x08\x72\x20\x13\x12\xbf\xc6\xa5\xac\xb5\xd8\x4a\xee\x7d\x7a\xc8\xdb\x33\x4f\xab\x86\x6e\x5b\x6d\xd9\x11\xce\x62\x3a\x99\x1c\x8d\x71\xe1\x99\x3d\x0e\xcb\x70\x12\x1e\x1c\x4d\x8e\xe2\x77\x84\x75\xd2\xb1\x7e\x43\x7a\x70\x3b\xd4\xed\x9a\xf1\x7f\x82\xe0\x90\x21\xc6\x05\x6a\xc2\xc2\x90\xbb\x36\xa6\xe6\x50\xd6\x85\x15\x55\x11\xbc\x58\x13\x21\xac\x05\x4a\x1c\x12\x97\xe2\x19\x0d\x46\x94\xa2\x60\x3a\xc9\xff\x23\x58\x7e\xcf\x30\x6c\x42\x19\xfc\x5f\x74\x88\x1f\x9f\x9d\x81\xe1\x76\xec\xd8\x27\x42\x13\x72\xa1\x74\xe9\x74\xa2\xcb\xc1\x2e\x6a\x17\x94\xad\x59\x51\x70\x1f\xe3\x94\x4c\xdf\x72\x5b\x15\x2c\xe3\x4d\x3e\x1d\x1d\xa1\x37\xe7\xd7\x50\x8a\xe5\xca\xa2\x79\xae\x98\x76\x5b\x00\x01\x75\x9e\x0b\x9a\xd7\x08\xd6\x2b\x91\x91\xee\x58\xaf\x48\xa3\x87\x57\x5b\x11\x71\x24\xe6\x39\x6d\x63\x48\x60\x7a\x2e\xac\x66\x7a\x03\x46\xfc\x0b\x9f\x6a\xdd\xf1\xf1\x12\xdd\xfb\xd2\xc3\xde\x13\x03\x7a\x14\x5a\x6d\xce\x1b\xca\x8d\x9c\xe8\x64\x21\x30\x78\xcb\xed\x08\x2e\x0b\xb6\x19\xc1\x5b\xae\x05\x37\xed\xa8\x88\xc2\xd8\x8d\x77\x3e\xd6\x6c\x83\x91\x90\x56\xb8\x74\x1e\x44\x56\x30\x63\xc4\x62\x03\x18\x7f\x07\xca\xec\x8c\xff\x7e\xea\xe3\x1f\xc8\x26\xeb\x72\xce\xf5\x8e\x40\x87\x66\xc2\x24\x1c\x3d\xf9\x21\xac\xfe\xf1\xef\x9e\xfc\x30\x79\x7c\x76\x76\x72\x04\xc2\xf2\x72\xe4\xc2\x74\x07\x48\x18\x78\xf2\xc3\xb8\x8f\x0d\xbd\x8d\x59\xee\x1e\x3a\x25\xbb\x1f\x44\x09\x6d\xdb\xa6\x22\x4a\x7b\xf6\x1d\xef\x89\xbc\x48\xe3\x23\x0f\xb9\x2d\x9e\x9c\x58\xb0\x10\xa5\xb0\x3c\x3f\xf5\x43\xa0\xef\x30\x04\xed\x80\xa9\x22\xa2\xc2\xe0\xbb\xc1\xae\xd8\xc8\x09\x56\x2d\xfd\xa0\x61\x5e\xae\x6f\x13\x1f\x1a\x8c\xd1\x14\x3a\xbd\x6d\x48\x3d\xda\x95\xec\x3e\x10\xae\x6b\x2e\x5a\x8b\x3c\xea\x50\x79\xd4\xea\x39\xe0\xca\x23\x3e\x83\xc9\x39\xfc\x30\x63\xb8\xb6\xc7\x7e\x31\x7e\x9c\x61\xeb\x87\x23\x28\xb9\x31\x6c\xc9\xa7\x70\x74\xdd\x2c\x7a\xc6\xa4\x54\x24\xb9\x4b\xcd\x99\x0d\xde\x93\xf5\x0b\xeb\x5a\x3d\x3c\xea\xaa\xc2\xf4\xd7\xfe\x48\xd0\x8f\x35\xf3\xe0\xfa\x0d\x70\x28\x42\x73\xbb\xd2\xfc\x87\x66\x15\x06\x7d\x51\x67\x46\x0d\x13\x44\x9d\xa2\xeb\x07\xad\xb5\xe8\x2b\x04\xd3\xd5\x08\xcf\x12\xc5\x72\xea\x14\x0b\x46\xed\x3e\x27\xb5\x49\x58\xba\x27\xaf\x31\xf4\xb7\x3e\x73\x1c\xb5\x20\x0b\x7a\xb0\xc7\x11\xa8\xe2\x5e\x09\x63\xa7\xf0\xde\x63\xf4\xa1\xc3\x18\x1f\x87\xda\x0c\x6f\x11\xf8\x76\x30\x8b\x5d\x0e\x8d\x99\x23\x35\xbe\x57\xd0\x1c\x11\xd8\x1d\x35\x87\x66\xfb\xc2\xe6\xd0\xee\x6b\xe3\xe6\xd0\xff\xc0\xc0\x39\x61\xa6\xae\xf0\x7d\x83\xc8\xf9\xef\x6e\x2b\xd8\xc7\xc9\xe8\xfa\x44\x3b\x72\x9a\xf3\x85\x40\x25\x68\xb8\x16\xac\x08\xdc\x49\xcc\x0a\xa6\xe2\x99\x58\x88\x0c\x79\x31\x02\xbb\x74\x1d\x0d\xac\xd8\x1d\x4f\x2a\x06\x08\x90\x9f\x05\x99\x7a\x64\x64\xd6\x81\x1b\x55\x5e\x04\xf7\x56\x95\xa8\x19\x36\x3e\x70\xe2\x6e\xe3\x55\xf3\x65\x8d\xee\xc7\xc5\x0b\x72\x15\x4c\xda\x28\x2d\x53\x68\x82\x79\x67\x08\x43\x24\xe6\x9c\xef\xb1\xf3\x17\x5b\x18\x08\x83\x01\x24\xcf\xac\x8b\xfa\xe7\x1c\x6a\x29\x7e\xad\x39\xb0\x52\xc9\x65\x03\xd0\xd9\x5c\x42\x06\x75\xb8\x90\x4e\x32\x3d\xd9\xb6\x79\x09\x6f\xdd\x58\xfd\x00\x7b\x9b\xd1\xf3\x12\xda\x7e\x3d\x9c\x1a\xdb\xa2\xf3\xf6\x08\xa6\xc7\xe8\x7b\x89\xa5\x1f\x7e\xb7\x50\xba\x46\xfb\x44\xd2\xb5\xfa\x5a\x81\x74\xbd\x0f\x14\xc7\xde\x32\xfe\xfb\xc2\x88\x7f\x3b\xa9\x0c\xe4\x27\x27\x7e\x21\x6a\x2f\x2b\x65\xd8\x1c\x03\x5e\xda\x76\xd9\x34\x75\x48\xd4\x78\x29\xee\xb8\x69\xf9\xcd\xc0\x1a\xa0\xb5\xc4\x48\x3f\x6f\x57\xb7\xf8\x82\x15\xb2\x26\x71\x7f\x67\x6b\x82\xe1\xca\x0f\xdb\x31\x69\x21\xf3\xd6\x2e\xb3\xba\xe2\x19\x17\x77\x31\xb5\xc0\x61\xce\x25\x5f\x88\x4c\xa0\x47\xed\x9d\x48\x3f\x8f\x76\x9e\x82\xd1\xa2\x87\x44\x45\xa6\xb9\xe5\xd1\xb3\x73\x1c\xe6\x01\x93\xf3\x14\x7e\xd1\xbe\xd2\xa6\xe2\xc7\x27\x9d\x98\x33\x53\x65\xc9\x65\xee\x04\xff\x14\xde\x19\xae\xe3\x2e\x0f\x95\x2a\xa1\xca\x90\x7c\xed\xb2\xe6\x4e\xb3\x9d\x17\x6a\xed\x66\xd1\x02\xa6\xdb\x53\xa2\xd8\x05\xd5\xe5\x4d\xdc\x09\xdb\x84\x59\x5f\xd6\xf3\x42\x64\x97\xcc\xae\x8e\x4f\x6e\x5c\xb9\x09\xfa\x3d\x2d\x70\x41\xa5\xe5\x7c\xc1\xea\xc2\x26\xa3\xc6\x49\x39\xaf\x95\x76\x4f\x58\x51\xa8\x35\xf6\xd1\x54\x85\x54\x57\x39\xa2\xde\x71\x0e\x38\x64\xac\x62\x73\x51\x08\x4b\x09\x6a\x0a\x7d\x6b\xaa\x60\xc1\x3e\xa4\x1e\x69\x07\x65\xe9\xd7\xac\x69\xde\xd3\x49\x01\x89\x29\x3c\x8f\x8d\x7e\x7c\xf4\x4c\x6e\xae\xbc\x64\x7f\x6a\x2d\xf8\x38\x4c\xfd\xf3\x5f\xda\xec\xf1\xda\x39\x4e\x82\xeb\x98\x4c\xcd\x58\x91\xd5\x05\xe2\x8f\x08\xb2\x52\xd5\x92\xa2\x38\xc3\x0a\x0e\x77\xac\xa8\x39\x58\xcd\xa4\x59\x70\xad\x5d\x8f\xf6\x3a\x78\x3e\x6c\xc8\xf4\x46\x59\x0e\xa7\x70\x61\x13\xaf\x79\xce\xed\x9a\x73\x09\x67\xe3\x33\xa2\xff\xe3\xf1\x59\x1b\xcc\xcb\x7b\xec\xb2\xf0\x81\x6d\x1c\x59\x18\xb8\x77\x11\x68\x83\xb8\x30\x70\x36\xfe\xd3\x53\x6c\x2a\x53\xce\x6d\x03\x74\xfd\xd7\x01\x01\xea\xf1\xff\xe0\x7e\xdc\x97\x16\x56\x14\x1b\xa8\xb8\xce\xb8\xb4\x6c\xc9\x89\xe1\xa3\x05\x76\x7b\x39\x96\xeb\xd2\x20\x51\xe6\xcc\x08\x03\x95\x12\xd2\xb6\x7d\x41\x21\xc1\xa8\x42\xe4\xb8\xd6\x73\x86\xa4\x35\x25\xba\x81\xa1\x98\x0e\x23\x5f\x51\x20\x4b\xe4\xa4\xa1\x15\x9a\x45\x03\x37\xef\xce\xc5\xfd\xd3\x1f\x6e\xba\xbc\x83\xf6\xb8\xd0\x9c\xe5\x9b\x58\xc7\xe6\xe4\x36\x19\x9f\x58\x28\x63\x06\xa9\x9b\x31\xfc\x81\x91\x65\x3b\x28\xad\xb8\x66\xce\xce\x33\xcd\x01\x3d\x0a\xcd\x8b\x0d\xe4\x1c\x67\x24\xa4\x30\xd6\x67\xe9\x97\xe8\xe7\x26\xad\xd1\x92\x7b\x7d\xd4\x96\x93\x0a\x39\xe0\xcf\x01\x05\xb5\x80\x4a\xf3\x4c\x18\xa1\x64\x3f\x7c\xcc\x6a\x3b\x05\x37\xc3\x36\x1b\xc6\x2c\x48\x6b\x77\xca\xd5\x7b\xba\x54\xbf\x13\x1f\x9c\x14\x0e\xc1\x36\x21\x77\xe4\xd7\x7a\xd4\x93\x35\xcd\x0b\x87\xfb\x4a\x54\x91\xdd\xf0\xc5\x8d\x4b\x64\xdc\x84\xcd\x5a\xd4\xaf\x23\x1f\xae\xa3\xb3\xb0\x04\x5e\x18\x3e\xec\xd8\xab\xb5\xe4\xda\xbb\xf6\x6b\x26\x29\xf2\x73\x9e\xd6\xa6\x3f\xdb\x9d\xfb\x96\xe4\x3c\x7c\xbd\x14\x8f\x52\x5a\x8e\x86\x86\xea\xda\xca\x4a\xf3\x01\xa3\x98\xd5\x16\xfe\x32\x23\x31\x7c\xf4\x88\x7e\xfd\x38\x23\x61\x9c\xc2\xd1\xf3\xda\x7a\xa9\x69\xe4\x56\x48\x7c\x24\x72\xd0\x4c\x2e\x39\x88\x31\x87\xf7\x67\xa3\xc7\x1f\x8e\xf6\xc5\x84\x51\x3d\xcf\xa2\x66\x18\xc8\x83\xd6\x18\xbf\x64\xb5\xed\xbf\xda\xbf\x81\xf8\x05\x51\x62\xb0\x95\xae\x24\x35\x76\x78\x9d\x5a\x67\xe4\xbb\x5f\x6b\xae\x37\xce\x98\xdc\xc4\x6a\x8a\x9b\x60\x71\xa9\x56\x99\xbc\xcc\x08\x01\x59\x8a\x04\x2b\x71\x53\x2b\xb6\x49\xca\x33\x9c\x2e\x50\xc4\x8a\x86\x47\x37\xdd\xb1\xea\x1e\xe3\x8e\xfd\xbb\x11\xab\xd6\x6c\xe3\xf9\x53\xb3\xec\xd6\x69\x05\x21\x73\x71\x27\xf2\x9a\x15\x03\x25\x54\x6e\x3b\x8a\x72\x93\x27\x41\x2a\x2f\xe4\x42\x99\x29\xbc\xf7\x84\xf9\xd0\xde\x06\xf2\x8e\xee\x40\xbb\x2e\x93\xa1\x7f\x84\xec\xe1\xac\x07\xb3\x60\xea\x92\xb6\xfb\x8b\x82\x98\xab\xd1\xda\xd1\xcc\x0f\x65\x1c\x1e\x8f\xcf\x5a\x60\xef\x18\xfa\xc4\x96\x15\xcf\x89\x41\xce\x3a\xaf\x71\x6d\x83\xce\x17\x32\xe2\x39\xc0\xee\x09\x90\xf8\xf5\x0f\xa1\xef\xb8\xcb\x78\x6d\x36\xf6\x99\x94\xd8\xcf\x09\x4a\x9a\x4a\x79\xeb\x26\x1b\xc7\x3f\x7c\xb6\x9d\x9c\x0a\x2e\xac\x31\x62\xe9\x14\x56\x80\x37\x28\x2f\x6e\xa4\x59\xbf\x51\x67\x93\xe0\xca\x39\xb5\x29\x3c\xca\x6d\xa4\x8d\x5a\x1d\x92\x88\x80\x92\xab\x69\xd2\xde\x15\x5b\xf0\x84\xad\x1d\x9f\x0e\x6f\x02\x24\xd1\x42\x53\x92\x74\x92\x70\xd1\x8e\x5d\xc5\x81\x69\xc1\xae\x90\xa9\x11\x94\xff\x68\xd4\xd4\x04\x4d\x57\x1d\x92\x6c\x8b\x9b\x1a\x4a\xec\x09\x9d\x9a\x02\xd2\xaf\x8c\x9e\x22\x80\x03\x03\xa8\x54\xd7\x74\xe5\xe7\x9b\x94\x02\x38\x53\xea\x36\x0a\x49\x47\x44\xeb\x42\x2e\x28\x49\x33\x99\x08\x64\xb5\xb6\xfe\x8a\xb9\x62\xaa\x37\x6b\x40\x90\x13\xce\xef\xb8\xb4\x35\x79\x6f\x29\x2c\x16\xfd\x69\xb3\x16\x36\x5b\xcd\x15\x06\x65\xc1\x08\x8d\x22\xdc\x95\x5b\xf3\xb0\x29\x30\xaf\x3d\xd8\x90\x89\x6e\x90\x8b\x04\xc2\x5f\x52\x75\x8a\xcf\xba\x7b\x5e\x4d\xb4\x11\xa3\xad\x80\x10\x06\x76\xa9\x31\xdc\xca\x27\x83\xa1\xcb\x34\x05\xfd\xa9\x4b\xfa\x49\x45\x2f\x27\x3e\x00\x3c\xbf\xbe\x4a\x47\x1a\xd8\x9b\x8f\x2e\xee\x28\xec\xcf\x53\x0c\x47\x85\x6a\x5a\x73\x53\x29\x91\xe3\x8a\x50\x21\x05\x72\xd6\x56\x6b\xf5\x1a\x5b\x74\x2d\x15\x6d\x7b\x07\x02\x10\x8c\xad\xca\x02\x59\x72\x41\x7b\xe5\x5b\x2b\x9e\xdc\x79\x99\x5c\xb0\x53\x8a\x3e\x33\x55\x72\xe3\xad\x2a\x0e\x42\x7a\x18\xdf\x4c\x4c\x3d\xa7\x16\xcc\x78\xa7\x61\xce\x73\x58\x71\xdd\x89\xce\xe2\xce\x27\xbf\xe3\x05\xfa\xbd\xe3\x52\xfd\x4b\x14\x05\x1b\x2b\xbd\x9c\x70\x79\xfa\xee\x2d\xed\x8a\x4e\xfe\xc1\xe7\x93\x5f\xae\xaf\x2f\x27\x3f\x33\x23\x32\xf3\x51\x2d\x3e\xd2\xcf\xd7\x17\xaf\x5f\x7e\x24\x75\xb3\x73\x5a\x91\x78\x5b\x3c\xc2\xc1\x69\x8f\xfa\xdd\xda\x82\x4c\xaa\x12\xbb\xce\xf0\x4f\xf7\x45\xec\x3c\x8b\xdf\xbe\xc6\x69\xa2\xce\xed\xc4\xfa\xe0\xc2\xff\x1b\x59\x75\xc7\x38\xc2\xf2\xb2\xbf\x11\x46\x4f\xa7\xf0\x9e\xda\x0c\xe4\xc9\x5b\xaf\x87\x53\xe4\xd8\x04\x66\x1d\xf8\x7b\x0c\x8a\x9f\xd2\x77\xb2\x26\x7e\xf4\xdd\xa6\xc4\x35\xda\x67\x47\x5c\xab\xaf\x35\x22\xae\xf7\x81\x16\x24\xb2\x01\x74\x3e\xdf\x2a\x1f\x9e\x6a\x2b\x60\x50\x88\x8c\x4b\x43\xc7\xc0\x94\x26\x1d\x65\x55\x94\x68\x53\xe5\xf7\x24\xc4\xbe\x95\x99\xb4\x2d\x09\x61\x9d\xd6\x93\xf9\xfa\x92\x50\x87\x13\x0f\x17\xa1\xcd\xf1\x30\xf2\xad\xaa\xef\x95\x47\xa5\x9f\x45\x46\x3c\x2e\x62\x4d\xcf\x16\xf1\xff\x98\x94\xfd\xec\x2c\xdd\x6a\x43\xa3\xd3\x21\xe1\xc7\xa1\x9c\x1d\x50\xfd\x4e\xac\x1d\x86\xdf\xcd\xdb\xbe\xd5\x3e\xe6\xf6\xcd\xbe\x96\xbb\x7d\xf7\x03\xd9\xbb\xbf\xc6\xbf\x01\x7f\xc7\x4a\xb9\x77\x57\xaf\x1c\x7d\xd1\xeb\xb1\xbc\x04\xaa\x9c\x8f\xe7\x17\xc0\x08\xdb\x58\xe2\x56\xca\x84\xb8\x79\xbe\x49\xcb\xdc\x90\x83\x6f\x39\x8c\x63\x45\xdb\xcf\x85\xca\x10\xba\x0a\x15\x72\x2e\x87\x19\xe1\xf9\x95\x55\x5a\x2c\x05\x8e\xd6\xe4\x61\xdd\x81\xbb\x6d\x72\x90\x1c\xa4\xf8\xa2\x92\xc5\x8f\x70\x40\xd1\xe2\x6c\x67\xad\x61\x67\x73\x33\x41\xe4\x3b\x71\x7a\x8a\xc2\x9e\x1d\xce\xe4\xf8\xc9\xbe\x4d\xce\xe4\x94\xdb\xd7\xee\x73\x36\x20\x0e\xdd\xea\x1c\x5c\xd5\xdf\x8e\xfb\x5d\x2e\xa3\xa9\x03\xf2\x15\x80\x54\x37\xea\x8f\x56\x5b\x2d\xf8\x1d\xef\xb2\xe3\x7e\x39\xb0\x0a\x0c\xb7\x75\x05\x8c\x74\x7b\x53\x74\xe5\xbc\xde\x4a\xa3\x13\xd8\xc8\x01\x0e\xc9\x96\x6e\x50\xe7\x58\x37\xd9\xf9\x5d\xbb\x32\xbd\x03\x41\x09\xdd\x9a\x12\x4a\x19\xe1\xaf\xc9\x35\x25\x61\xf7\x26\x47\x87\x4d\x92\xb8\xe9\xe9\xca\x66\xfb\xf9\x46\x0f\xe3\xd2\x97\x1b\xc6\x1f\x9d\xa2\x4d\x87\x3d\xc5\x4e\xae\x0a\xab\xac\x0d\x25\x25\x50\xb6\xdd\x20\x9e\xfc\x03\x13\x8d\xe5\x3c\x61\x5b\x19\x62\xbe\x3b\x2b\x6a\xb2\xbd\x71\xdf\x8b\x26\x10\x36\xb4\x7c\xdd\x98\x2f\x49\x73\xa7\x80\x9b\x97\xbd\xb9\x54\x31\xb2\x49\xa3\x9c\xce\x4c\xb4\xb8\x63\x96\xa7\x53\x69\x42\xc9\xde\x64\x28\xe6\x74\xc5\x44\xba\x05\x26\xd9\x94\xb1\x8a\x56\x3f\xd7\x6c\xed\x12\x7b\x94\xe2\x73\xde\x40\xe4\x8f\x95\x2a\x68\x9e\xd8\xa0\x8f\xb7\x1f\xc1\x63\xee\x30\xdc\xba\x08\x09\x54\x0a\x52\x42\xc5\x78\x2b\x7d\xe8\xcf\xb8\x9b\x7a\xb1\x10\x19\xd5\x2d\x6b\xce\xf2\x53\x0a\x4b\x9b\x83\xef\x81\xea\xad\x61\x42\x51\xa8\x81\xe3\x9c\x57\xca\x08\x0b\x7f\xf0\x47\xb7\xe1\x0f\xfe\xfc\xf7\x9b\xf3\xeb\xf6\xae\x5c\xbb\xf2\x16\x75\xfd\x9c\x65\xb7\x6b\xa6\x73\x43\xdb\x9c\xcc\x0a\x4f\x2e\x92\x94\x5e\xb9\x22\xd5\x16\x48\x65\xfd\x86\x12\x55\x7d\x0e\xe0\xd6\xbd\xfa\x61\xdc\xc8\x89\xa7\x4e\xb3\x19\xba\x5e\x71\x89\xe2\x4a\xf5\x0f\x75\x95\x8e\x39\xa6\x92\x2d\x99\x1c\x37\xa4\x35\x6d\x1a\xf8\xb2\xbd\x92\x6d\x92\x6a\xad\x39\x07\xfe\x6b\xcd\x8a\xa0\xcf\x89\xfa\x3e\x17\xeb\x76\x78\x6e\x1c\x07\xbe\x22\x36\x42\x75\x79\xd3\x17\x38\xd7\xa4\xc1\xdb\x1d\xf2\xef\x54\xc5\xc5\x75\xed\xf1\xa6\xdf\x53\x60\x0b\xa5\xe9\x34\x9b\xab\x68\xab\x1a\xf9\x1c\xc7\x5c\x87\x44\x15\x58\xe0\x82\xb7\x80\x6b\x6e\xac\x16\x8e\x53\x70\x1c\x5a\x90\x12\x63\xaa\x46\xb4\x68\xff\x8d\xcd\x0b\x57\x66\x79\x83\x5a\xb2\x4b\xe9\x9b\xf6\xee\x09\xb5\x09\xd9\x02\xbf\x3f\x7a\xd3\xba\xfb\x61\xdc\xbf\x5f\xe0\xa6\x25\xea\x54\xb4\xff\x6b\x2d\x06\xf5\x54\x97\xb2\xdf\x86\x6c\x89\x32\xe8\xd3\xad\x05\x9b\x0d\xd3\x8d\x4a\x5e\x4a\x21\x45\x59\x97\x0d\xad\xfc\xd5\x16\x3a\x99\xdf\x56\xa1\xdf\x3d\xa5\xf3\x50\xa1\xed\x77\xf3\x0a\xb5\x36\x6e\x93\xdb\x1f\x51\x43\xaf\xae\xac\xec\xa6\x6b\x90\x82\x56\x40\x04\x82\x19\x20\x1b\xd0\x02\x1f\xb4\xf2\xc0\xae\x1b\x25\x9b\x5f\x22\xe8\x94\x57\x8f\x8f\x4f\xa6\xf0\xd7\x1d\x62\x78\xb2\xeb\x80\xd9\x36\x63\xd3\x3e\x4b\x36\xac\xc6\x3b\x6d\xb6\xa9\xcc\x21\x50\x5d\x61\x1b\x6a\xd3\x5d\x86\xe1\xe1\x76\xb7\x1a\xa4\x59\x58\xc1\x83\x68\x17\x20\x1d\xb6\x0f\xd7\xc5\x7c\x2c\xcc\x5b\x97\xb9\x3a\x56\x0b\x87\xe0\x8f\x8f\x3e\xed\xd5\x99\xa3\xbe\x5a\x0d\x82\x3c\x82\x7d\x22\xfc\x19\xbd\xc0\x29\x1c\x79\xf5\x4b\x92\x41\xbe\x81\x3f\xc7\xbb\x5f\x65\xef\x1c\x1e\xd5\xc8\x3e\x14\x52\xbd\x75\xd4\x27\x52\x6f\xe9\x0e\x24\x53\x10\xe2\x01\xfc\xfa\x53\x38\x98\x4c\x1e\xe8\x21\x84\xfa\x22\x04\xbe\x8c\x50\xe3\xbd\x5b\xaf\x89\xa8\xce\x92\xef\xfd\x86\x8d\xb4\xce\x9a\xaf\x03\xcd\x12\x81\x85\x59\x4b\x7e\xb7\xc1\x6c\x10\x9f\x75\x1f\x6c\xeb\xd2\x2c\xf2\xac\xfb\x60\x3b\x4a\x4d\x9b\x04\xb1\x5d\x1d\x07\xe5\x7c\xb6\x53\xfa\x0f\x8d\x3c\xfb\xae\x3f\xc5\x9f\xeb\xb0\x5f\x4b\x9b\x0b\x3e\x14\x62\xce\x01\xcc\x63\x21\xc4\x7f\x26\x32\xed\xa3\xb8\xf7\x42\x8a\xce\xf5\x06\x7b\xa2\xd4\xfe\x05\x2b\x5f\x19\xab\xf6\x00\x1d\x18\xb1\xee\x8a\xbf\xc2\xe7\x3f\x16\xb7\xa2\xdd\x5e\xa9\x35\x95\xe8\x04\x73\xfd\xfb\x66\x63\xab\x31\xf9\xe3\x83\xe2\x57\x77\x1d\x92\x04\x75\xc7\xb5\x9b\xb0\x4c\xee\x4b\xa2\x23\xf7\x22\x33\xa1\x32\xaf\xe7\x54\xf8\x10\x73\xce\x0b\x25\x97\x08\xf0\xc0\x20\xb6\x77\x4e\x18\x9d\x79\x56\xf6\xdc\x35\x42\x9b\x3c\x77\x7f\x0c\xde\x95\xec\xf8\x61\x93\xc9\xf6\x1c\x96\x6d\x77\x1e\xc0\x8b\xa4\x08\x64\x68\xb4\x21\xa2\x84\x80\x75\xd7\x80\x7b\x6e\x18\x88\x79\x0f\x97\xfe\xa2\xdb\xcd\x7c\x5a\x8e\x86\xa0\xa2\xbd\x74\xbd\xd9\x5c\xd5\x76\xff\xb0\xdb\xae\x7c\x6a\x8d\xfd\xf6\xd7\x9a\x69\xee\xf7\x4d\xdc\xb9\xd3\x56\xfa\x7b\xef\x28\x86\x00\x5c\x94\x54\xa3\x40\xa9\xf9\x16\xfc\x9f\x99\x94\x5c\xb7\xe0\xc7\x0a\xca\x06\xec\xa8\x9b\x87\xa0\x28\x8f\xd1\xe9\x2b\x90\x9c\x69\x78\xfc\xe4\xec\xec\xfe\xe9\x1f\xcf\xfa\x08\xcc\x69\x84\xad\x08\xbc\x55\x99\xf0\xa4\x35\x6e\x6a\x2c\x5b\x75\xc7\xff\xbd\x01\xe3\xda\xad\x54\xc9\x2b\xb6\xe4\xad\x33\x3f\x70\xa9\xfc\x09\xeb\x5b\xbe\x89\xc1\xde\x91\x90\xc6\xb2\xa5\x66\xe5\xd1\x08\x8e\xec\x5a\x58\xcb\x35\x7e\xcd\x85\xc9\x94\xce\x8f\x3a\xd7\x2f\x44\x8a\xd1\x48\x66\x0a\x9f\x1c\x2f\xb4\x16\xe7\xb7\xba\x76\x61\x1b\x33\xb4\x5b\xf5\x17\xb3\xfd\xbe\x4f\xeb\x4e\xff\xdd\x53\x0b\xcd\x7e\xd3\x0b\x1e\xbe\xe0\xd2\xa9\x64\xba\x30\x4b\x27\xdf\x6f\x9a\xcc\x1c\x66\x29\x1d\x06\xa0\x3a\x22\x20\x44\xf7\xed\xeb\x4c\x7a\x7a\xd5\xc4\xb0\x55\xf7\x46\x3d\x42\xfb\x8e\xd6\xfd\x8b\x2c\xfb\x61\xd7\x53\x0c\xde\x84\xf6\x4d\xec\xfb\x17\x5d\x5c\xb1\xc7\x3a\x85\xcf\xb7\xb7\xf2\x9a\x69\x57\xc9\xdd\x28\x7e\x7f\xf6\xc6\x5d\x6d\xe3\xde\xc7\xde\x54\x06\xed\x62\xff\xd0\x15\xfd\x02\x13\xb5\x29\x17\x74\x6e\x05\x55\x13\x9d\xe3\x4d\x45\x6a\x5e\xd3\x1d\x96\xe8\x11\x44\x80\xd4\x69\xae\xbc\xd7\x3d\x54\x35\xe8\x46\xf9\xd4\x49\xef\xf1\x30\x84\x2f\xd9\x77\xad\xe8\x7a\xd0\xce\xf9\x94\xa8\x10\xb1\x7d\xa8\x36\x1d\x38\x85\x5a\xb2\x7b\xca\x9a\xb8\x6a\x51\xb5\x70\x1d\x7a\x60\xdc\x39\xc6\x6d\x40\x06\x6e\x31\x4a\x51\x73\x47\xc7\xf7\x5c\x18\x10\x0f\xe7\xbe\xe2\x4b\x2e\x73\xa6\x37\x23\x78\x59\x61\x50\x75\xc5\x34\x1f\xc1\x3b\x89\x46\x0c\xcd\xd9\x73\xfa\xb7\x7d\x4a\xd7\x9f\x4e\xa7\x59\x1c\xe2\x23\x74\x8f\x71\xb6\xc9\x34\x6a\xcd\x77\xb0\x46\x77\xe8\x34\xa7\x5b\x9b\x99\x3b\xcf\xf9\xe8\x51\x8b\x2c\xb3\x6d\xa7\x3c\x2b\x26\x45\x76\x7c\xf4\x2c\x2c\x79\x64\x2c\x13\x56\xaf\x7d\xf5\x96\xd2\xc4\x38\xbd\xa3\x9c\x03\xba\xd2\xa1\xd3\x59\x51\xd8\x7e\x58\x13\xfe\x8d\x8a\xdd\x4e\x2d\x9f\x9b\x0b\x09\xfa\xf7\xaa\xe6\x73\x28\xec\x29\xe5\xa3\x46\x7b\xeb\xf8\xa8\xd5\x57\x17\xf1\x51\xef\x43\x2b\xf8\xba\x72\x1f\x3e\xbf\x51\xfd\x85\xd7\x77\x6e\xcf\x20\xbd\xf2\xd7\x6d\x55\xf7\x37\xe6\xc2\xe5\xae\x7e\xa1\xfd\x1d\x74\x6a\x91\x16\x2e\xdf\xf2\xcd\xc4\xe9\x93\x8a\x09\x1d\xae\x8c\xa5\x4c\xad\x51\x25\x4f\xa2\x26\x69\xf9\xbd\xad\x59\x41\x1e\x2c\x8d\x1b\xfc\x6f\xee\x40\x6f\xd3\x8f\x74\xd5\x5d\x3b\x90\xe9\xde\x09\x40\xfd\xc7\xf0\x4a\xdc\x72\xf8\x99\x65\xb7\x4b\xad\x6a\x99\x8f\xe0\xe5\x86\x9b\x11\xfc\xc2\x84\xde\xe2\x43\x6e\x8d\x61\x70\x84\x5a\xe6\x5c\x17\x9b\xa8\x6c\x5a\xa3\x8d\x02\x9b\xda\xf0\xd8\xdd\x8b\xeb\xae\x4d\xa3\x26\x71\x53\xc8\x4f\x3e\xf0\x36\x01\xeb\xe3\x42\x8f\x93\xaa\xb2\x16\x3e\x3e\x38\xa3\x9c\x49\xb2\x2e\x18\xa8\xba\xb3\x9b\x61\x0c\x47\xd4\xb5\x3b\x0a\x21\x8c\x23\x13\x86\x9c\x6e\x0a\x91\x21\x52\xe0\x68\x0f\xc9\x09\x97\x19\x1f\xc1\x46\xd5\x5e\x43\x9b\x80\x95\x0b\xa6\x6a\x29\xee\xc1\x8a\x92\x1b\xcb\xca\xca\x65\xc0\xfc\xb1\x8a\x16\x7e\xcc\xc0\xd1\x0b\x66\xf9\x11\x4d\x98\x17\x45\x3a\x56\x55\x30\x8b\x96\x98\xf4\x5e\xa6\xa4\xa9\x4b\x1f\x66\x3b\x9a\x91\x15\xa1\xca\xf4\x70\xe0\x6b\xab\xbd\x4b\xc6\x1c\xbc\x7b\x21\x48\x18\x9a\x63\x56\x18\x15\x03\x50\x57\x44\x51\x6c\x3c\xe7\x33\x6b\xb5\x98\xd7\xb6\x75\xd7\x4a\x9b\x19\x9c\x34\x44\x85\x13\x4e\xee\x10\x7a\x45\xd1\x40\x30\xa4\xd3\xfd\xd4\xfc\xb3\xb0\xec\x94\x46\xf0\xc6\xb2\xbf\xfa\xee\x79\x54\x40\x3b\xae\x1e\x18\xf5\x38\x65\x34\x48\x8a\x51\x17\xe6\x97\x87\x0b\x6e\xf1\x67\x1d\x5b\x0b\x9d\xab\x6b\x7d\x22\x2f\xf9\xd5\x6f\xea\x7d\x84\x59\xea\x6e\xc1\xde\xca\x46\xd2\x60\xce\x49\xf7\xb5\xec\x41\x09\xed\x57\x59\xbe\xa3\xef\x40\xc5\x81\x07\x68\xad\x08\x2e\x15\xaa\x01\xad\xe5\xa2\x5f\x52\x3b\x83\xfa\xca\x0c\xd4\xd3\x84\xcb\x84\xdf\x53\x8b\x7e\x71\x64\xe7\xfd\xe0\x72\xed\xbe\x50\x37\x7c\x5a\x2e\xd7\xb3\x3c\x37\x8d\xfa\x77\xda\xd4\xb3\xa4\x47\xf5\x4e\x74\x36\x66\x5b\x3f\xbc\xc9\xa6\xb6\xee\xca\x5d\x27\xa9\x7e\xba\x6e\x9f\x96\xe5\x39\xcf\x07\xbd\xbe\x60\x82\x59\x9e\x13\x08\x9c\xa8\xbf\x50\x79\xc7\x0c\xc7\xc8\x05\x32\x3f\xb6\x3b\x2e\xe5\x69\xfb\x21\xc9\x5c\xbe\x97\x1f\xe2\x51\xd8\xed\x87\xf8\x9b\x5b\xf7\xf8\x21\xfe\xc2\xe9\xaf\xf4\x43\x5c\xef\x03\xfd\x90\x1e\xbf\x86\xcf\x37\xf0\x43\xfc\x12\xc5\x6b\xaf\x30\x2c\x63\x46\x14\x74\x4e\xe5\x8e\x6b\x4b\x77\x0d\xd0\x3b\xa6\xa9\xb2\xc3\x2f\x3f\xd5\x0b\xbc\x39\xbf\x4e\xee\x1f\xe8\x56\x30\xe4\x8a\xf4\x2f\x29\x5c\x1f\x94\x85\x8b\x75\xe2\x6d\x4d\x28\xe3\xde\x24\x5f\x3b\xab\x1d\xe1\xb9\x7a\x03\x6e\x57\x2a\xde\x66\xe3\x8a\x37\x78\x4c\x50\xda\x15\x2f\xdd\x35\x46\x9a\xd1\x81\x6b\x77\xa8\xce\xa3\xb8\x8d\xa7\x70\x3e\x4e\x4e\xda\x33\x9b\xf3\x30\x69\xa7\xa0\xae\x1b\x09\x4e\x7a\xf3\x7b\xda\x9c\xca\xdf\xb0\x92\x9b\x69\xfb\xa4\xbf\x0b\x7c\x1c\x36\xde\xf0\x86\x03\x96\x37\x38\xd6\x4d\x04\x16\x3e\x94\x67\x73\xd1\xac\x76\xe6\x6a\xcd\x64\xbc\x98\x21\x43\x1d\x77\xe3\xf0\xb8\xe9\x31\xf6\x75\x38\x02\xc1\xb0\x43\x57\x55\x74\x39\x1b\xc7\xbf\x56\x9e\xb9\x1d\x09\x62\xf2\x2a\x1a\xaa\xcf\xa3\xee\xfc\xde\xbb\x36\x1f\x7e\x3a\x99\xf6\x19\x71\x32\x81\x24\x35\x42\xa7\x3a\x8d\x3f\xd6\x19\xa6\x12\x0d\x83\xf7\xbe\xdc\x81\x6d\xd1\x5c\xab\x15\x76\xfb\xf2\x71\xc7\xbd\xdb\x74\x0e\x88\xae\x98\xcc\x0b\xee\xf4\x3e\x11\x97\x15\xc5\x86\x4e\x9c\xda\xa6\xf1\x3f\x6b\x93\x8c\x4d\xfc\x11\xe0\x83\x2b\x17\x18\xa7\x02\xdb\x9a\xec\xf0\xed\x3f\xe8\x7b\xdd\x22\xda\xad\xb6\x0f\x07\xc4\x11\x89\x3a\xd6\xbc\x54\x77\xfc\xf8\x96\x6f\xa6\x70\xbb\xed\x8a\x9f\x24\x42\x1c\xb0\x3b\x30\x83\xf7\xcd\x7f\x86\x11\xc7\x27\xf0\xc4\x2f\xed\xa1\x23\x04\x98\xb9\x15\xf2\xce\xc8\x6d\xf4\x43\xb0\xe7\xfb\xdb\x0f\x0f\x3b\x6e\x88\x14\x45\xe3\x82\x48\x51\xb4\xb1\xed\xa8\x79\x32\x07\x43\x13\x08\xcc\xe8\x18\xcb\xf5\x8a\xf7\x5b\x7f\xfe\xbf\x00\x00\x00\xff\xff\x20\xdc\xf6\x60\xce\x66\x00\x00")
-
-func blockchainContractsMetadataviewsCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainContractsMetadataviewsCdc,
-		"blockchain/contracts/MetadataViews.cdc",
-	)
-}
-
-func blockchainContractsMetadataviewsCdc() (*asset, error) {
-	bytes, err := blockchainContractsMetadataviewsCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/contracts/MetadataViews.cdc", size: 26318, mode: os.FileMode(0644), modTime: time.Unix(1677636844, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf7, 0xec, 0x91, 0x97, 0x39, 0xf8, 0xe0, 0x45, 0xf, 0x99, 0xa, 0x5, 0x63, 0x41, 0xb3, 0x70, 0xfe, 0x4e, 0xb2, 0x1a, 0x8b, 0x9a, 0x97, 0xbf, 0xc1, 0x9d, 0x9b, 0x9b, 0x93, 0x4b, 0x44, 0xc3}}
-	return a, nil
-}
-
-var _blockchainContractsNonfungibletokenCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xc1\x8e\x1b\xb9\x11\x3d\x6f\x7f\x45\xad\x17\x88\x67\x0c\x8d\x94\x43\x90\x83\x00\x23\x4e\x76\x76\x00\x5d\x26\x8b\x89\x82\x1c\x16\x06\x44\x35\xab\x25\xc2\x6c\xb2\x4d\xb2\x25\x2b\xc6\xfc\x7b\x50\x45\xb2\x9b\x2d\x69\xec\xf1\x2d\xbe\x58\xd3\x4d\xbe\xaa\x7a\xf5\xea\x91\xbd\x78\xf7\xae\xaa\x7e\xf9\x05\xd6\x7b\x84\x07\x6d\x8f\xf0\x68\xcd\xdd\x43\x6f\x76\x6a\xab\x11\xd6\xf6\x13\x1a\xf0\x41\x18\x29\x9c\xe4\x85\x9b\x47\x6b\xf2\x7b\x7e\xbd\x81\xda\x9a\xe0\x44\x1d\x40\x99\x80\xae\x11\x35\x56\x15\xe1\x0d\x7f\x42\xd8\x8b\x00\x42\x6b\x30\xd6\xdc\x35\x19\x3d\x30\x7a\xde\xed\xa1\xb6\xbd\x96\xf4\x77\x63\x5d\x0b\xc1\xce\xab\x55\x03\x02\x7a\x8f\x0e\x8e\xc2\x04\x0f\xc1\x82\xc4\x4e\xdb\x13\x08\x30\x78\x04\xd3\x84\x61\xff\x0c\xc2\x1e\x95\x1b\xb3\x39\x32\x9c\x41\x94\x55\xb0\xa0\xda\x4e\x63\x8b\x26\xd0\x32\x38\x2f\x62\xcc\x75\xce\xb9\x5f\xe2\xec\xc5\x81\x32\x86\xc6\x6a\xa2\x89\x8a\x21\x20\xd7\x6b\xf4\x20\x8c\x04\x23\x5a\x65\x76\x15\x97\x1a\x26\xd5\xfb\x0e\x6b\xd5\x28\xf4\xf3\xc4\xe0\xc3\x7a\x03\x0e\xbd\xed\x5d\xa6\xaa\xb6\x0e\x87\x47\x10\x4e\x5d\xe2\xcc\x61\xe7\xd0\x23\xd5\x2e\x0c\x3c\x3e\xac\x41\x19\x46\xf7\xad\x70\x63\xed\x09\xf8\x57\xab\x35\xd6\x41\x59\xb3\x81\xa7\x09\xfe\x08\x4d\xa8\x3e\x58\x47\x59\x33\xb5\x6f\x3d\xe3\xd6\xc3\xde\x79\xb5\xa2\x56\xd6\xba\x97\xbc\xa8\xc1\x23\x34\xbd\xe1\x77\xdc\x02\xc1\x0c\x50\x16\xf6\x68\xd0\xd1\x23\x14\x5e\xe9\x53\xd5\xda\x43\x6a\xab\xa7\x44\x89\x16\xdb\x07\xb0\x0d\xaf\x2e\x43\x70\xbe\xbf\x3b\x7b\x50\x12\xdd\x86\x57\x6e\x9e\xb0\x46\x75\xa0\x3f\x87\x74\x07\x12\x3d\xd7\xe1\xcb\x27\x20\xb1\xd6\xc2\x61\x91\xdc\x51\x85\x3d\x78\xdb\x22\x74\x0e\x19\xb4\xb3\x9e\x69\x92\x8a\x57\x54\x89\xd5\xcf\xbd\x72\xc8\x49\x8d\x9c\x15\xdd\xad\xd1\x05\xa1\x4c\xea\x29\x03\x6d\x71\x2f\x0e\xca\xba\x61\x1a\x7c\x54\xca\x09\x28\x05\x8f\x9d\x70\x22\x20\x6c\xb1\x16\x3d\xa5\x19\x60\xa7\x0e\xe8\x39\x06\x2b\x98\x7e\x88\xad\xd2\x2a\x9c\x28\x92\xdf\xd3\x3e\x01\x0e\x1b\x74\x68\x6a\x24\x91\x46\x05\x97\x29\x51\xba\xd6\xe8\x13\xe0\x97\xce\xfa\x84\xd7\x28\xd4\x32\xaa\x6e\xac\x5d\x19\xb0\x06\xc1\x3a\x68\xad\xc3\x2a\x71\x3e\xd2\x35\x87\x15\xcd\xa0\xb7\x29\x31\x4a\xca\x9f\x67\xd5\x8a\x4f\x08\x75\xef\x83\x6d\x87\x26\x24\xd2\x26\x03\x34\x6d\x04\x8d\xa5\x85\x83\x70\xca\xf6\x04\xa9\xcc\x2e\xf5\x82\xe0\xa3\x1e\xe6\x55\xf5\x8f\x13\xf4\x9e\xf8\x1c\x90\xb9\x84\x11\x68\x96\x92\xb2\x0d\x4b\x72\xaa\x71\x0f\xb5\x30\xe0\xd1\xc8\x8a\x76\xb9\x28\x96\xac\xb6\x0e\xd1\xdd\x05\x7b\x47\xff\xcf\x38\x36\x09\x8f\x5a\x66\x76\x94\x1f\x07\xe1\x69\xa6\xb4\x04\xd4\x48\xa8\x1a\x34\xca\x1d\xba\xea\x62\x9c\xd6\x96\x43\xe5\xa9\x23\xd5\x1b\x1b\xf6\xe8\x38\xc5\xd9\x60\x4b\xec\x0d\x9e\xb8\x39\x31\xb4\x74\x22\x8e\xc6\xe3\xc3\xba\x6a\x9c\x6d\x2f\x7a\xca\x3e\x65\xa0\xce\x0e\x22\xb1\xb3\x5e\x85\xa1\x93\x60\xcd\x24\xd6\x5b\x5f\x4d\x35\x5a\x5b\xea\x44\x88\xf2\x0d\x4e\x18\xdf\xa0\x9b\x57\xd5\xbb\x45\x55\x2d\x16\xec\xe4\x2d\x89\x37\x4e\xf5\xb9\x35\xcf\xe1\x9f\x0c\x5d\xbe\xa5\x66\x69\x4d\x9b\x55\xdb\x59\x17\x62\x5b\x8a\x7e\x2b\x5f\x78\xfb\x62\x51\x75\xfd\xf6\x0a\xf4\xa5\xab\x7e\xad\x2a\x00\x80\x94\x55\xb0\x41\x68\x30\x7d\xbb\x45\xc7\x9e\x10\x5b\xc7\x4a\x55\x3e\xba\x9e\x32\x80\x5f\x94\x0f\x3c\x11\xb4\x97\x42\x1d\x84\x8b\x9b\xff\xd5\x77\x9d\x3e\x2d\xe1\xdf\x2b\x13\xfe\xfa\x97\x01\xfc\xb7\x43\x4c\x53\x04\xc0\x56\x85\x80\x12\x8e\xc4\x71\xea\x43\x91\x2a\xd5\xa1\x82\x12\x5a\xfd\x17\x65\xda\x3e\x84\x41\x86\xf9\x35\x2d\x5e\x8d\x0b\x6f\x6e\xaf\x85\x52\x7e\x1a\x4d\xa4\x03\x4d\xf9\x41\x09\x66\x96\xf7\x29\x23\x55\x2d\x02\xab\x71\x30\xce\x0b\x5f\x4c\xc0\x01\x8e\xa2\x00\x01\xd2\xd1\xbc\xcc\x76\xb1\x80\xd5\xc5\x5e\xe5\xc1\xd8\x10\x7d\x17\x44\x5d\xdb\xde\x84\xb7\x9e\xcd\x5e\xec\x70\x06\x1b\x82\xd9\x70\xab\x61\x8b\xb0\x31\x4a\x6f\xe6\xd7\x39\xf8\x4f\x0a\x7d\xa3\x64\x26\x7b\xc6\x59\x2c\xe1\xef\x52\x3a\xf4\xfe\x6f\x57\x29\x79\x89\x8f\xa4\x71\x94\x3c\x48\x93\x83\xe0\xac\xaa\x90\x99\x4a\x56\xf7\x1a\xa2\x4a\xf4\x17\x0a\xba\x8f\x4b\x26\xf5\x04\x7b\xad\x9a\xd5\xf4\xd2\x92\x24\xe4\x87\xf3\x7f\xbc\x9e\x9c\x47\xba\x3c\xb4\x60\x45\xea\xfb\xca\x2b\x8a\x39\xe8\x8d\xfa\xdc\x23\xac\xee\x13\x69\xa2\xde\xb3\x4c\xf7\xc2\x0f\x4b\x09\x50\x63\x80\x31\x61\x7e\xf5\x3c\xe4\xf9\x14\xcf\xb0\x76\xe0\x9e\xfc\x24\x25\x47\x2a\xbb\x66\xa0\x54\x43\xde\xcf\x57\xa9\x46\x99\x78\x06\xa5\xcc\xc9\x94\x50\x46\xc7\x23\xcc\x84\xc7\x0e\x4f\xb5\x5c\xd6\xfa\xf8\xb0\x5e\x9e\x97\xf9\xdd\xdc\x0b\x8e\x2d\xb4\x28\x15\x9d\x9c\x59\xee\x1e\xb2\x6d\x16\xa6\xf9\x0a\xae\xf3\x65\x62\xca\xf7\xe0\xc9\x0e\xe9\x72\x32\x5c\xa3\x86\x18\x85\xa6\xc8\xf5\xe2\x22\x15\x20\x9e\xc6\x91\x11\x37\x29\xad\xe9\xcd\x00\x7b\x93\x7f\xac\xee\x73\xad\xb7\x4b\xf8\x30\xe5\x83\x37\xd2\x3d\x64\xfa\x88\xfe\x39\xf4\xbd\x0e\x73\x25\xe1\xfd\x7b\x28\xb1\xde\x90\x50\x56\xf7\x59\xf9\xa3\x17\xc4\x99\x6a\x7b\x1f\x68\x88\xf9\x2a\x28\x5a\x04\x11\xc7\x85\x6e\x36\xe8\x69\x14\x56\xf7\x6f\x26\xd1\x9e\xab\xe9\xaf\xef\x74\x23\xcd\x94\xcf\x3c\xfc\x50\x2b\xf2\x45\x2e\xfb\x7f\x0a\x94\x4f\xba\x20\x3e\x8d\x8d\x10\xfc\x4b\xb8\x5d\xcf\x52\xa6\x1e\x08\x29\xcb\x16\x9c\x85\x4e\xe1\x7f\xfa\x29\xf7\x22\xc1\xde\x30\x33\x91\xfc\xdb\x97\x4b\xe4\x51\x19\xfc\x31\x1d\xe0\xb5\x6d\x5b\xba\x65\xe5\xf5\x5d\xbf\xd5\xca\xef\xa1\xb1\x6e\xf8\xaa\x98\x24\xf1\x42\xe1\x63\xaa\xbf\x13\x42\x7d\x36\x14\xdf\xcc\xb6\x5c\xb4\xc3\xb0\xba\xf7\x37\xb7\x4b\xf8\x23\x8a\xea\xe3\xc5\x92\xad\x75\xce\x1e\x1f\x1f\xd6\x85\xa7\xdd\x2e\xe1\x4f\x79\x4a\xaf\x3b\x45\x2a\x28\x29\xdf\xd4\x8e\xee\x11\x93\xef\x8e\xc2\x1f\xb6\x98\xaf\xd8\x32\x7f\x76\x0c\x97\x02\xb2\x98\x6c\x2c\x2f\x2a\x62\xa4\x63\x39\x8c\xe7\x6c\x50\xc7\xec\x1a\x5d\xa5\x5e\xee\x15\xbf\x13\x8e\xaf\xa6\x7b\xab\xe5\x68\xc7\x29\x9f\x2b\xda\xc8\x17\x06\x3a\x39\x24\xad\x5d\xc2\x87\xaf\x91\x9f\x25\xed\x7d\xae\xfe\x2f\xfc\xe1\x5b\x93\x11\x07\xe3\x72\x12\xc6\x5c\x3c\xc8\x81\x9c\x12\x68\xd8\x14\xa2\x7d\xa4\x8d\x4a\x82\x70\x4e\x9c\x5e\xa7\xc6\x12\x30\x2a\x11\x1c\x86\xde\x99\x34\xaa\x4e\x9c\xb2\x2f\xd1\xbb\x38\x52\x0e\x73\x4f\xea\xeb\x3d\x79\x41\xd7\x65\xb0\xa7\x1c\x25\xa9\x1b\xe5\xf8\x79\x14\xaf\xe0\xe5\x27\xf0\x95\x38\x8b\x05\x78\x3b\x1e\xdc\xb1\x39\xfc\xdd\xe0\x50\x48\x90\x22\x08\xa6\x88\x2f\xdf\x2d\x86\xbd\x95\xe9\xb8\x51\xe1\x47\x26\xec\xdc\xdc\x1d\x5e\xf1\x76\x8f\xba\x99\x0f\x2a\xfc\x43\xc9\x8f\xf0\xf3\x7b\x30\x4a\x2f\xe1\x0d\x61\x48\x8b\xf1\xc6\xc6\x17\xde\xcb\xaa\x7e\x7e\xad\x81\xd7\x0e\x45\xc0\xdf\xda\x2e\x9c\x8a\x2f\x85\xf8\x94\x5b\x86\xf4\xea\xd2\xc2\x21\x7e\x47\x45\xce\xcf\x25\x5d\x12\x79\x62\x0a\xed\x91\xe9\xf7\x55\x49\xd2\xd5\xd8\xd4\xe0\x0f\x45\x2a\x85\x0b\x5e\x1e\x83\xe9\x08\xcc\xd2\x98\x6b\x34\xbb\xb0\xa7\xf3\xf0\xcf\xe9\x18\x8c\x31\x64\x39\x8a\xf9\xfc\xe3\xca\x0a\xa2\x32\x35\xcf\xd5\xff\x02\x00\x00\xff\xff\xcf\xe4\x55\x85\xd9\x12\x00\x00")
-
-func blockchainContractsNonfungibletokenCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainContractsNonfungibletokenCdc,
-		"blockchain/contracts/NonFungibleToken.cdc",
-	)
-}
-
-func blockchainContractsNonfungibletokenCdc() (*asset, error) {
-	bytes, err := blockchainContractsNonfungibletokenCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/contracts/NonFungibleToken.cdc", size: 4825, mode: os.FileMode(0644), modTime: time.Unix(1677636844, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xeb, 0x88, 0xe7, 0xbc, 0xe3, 0x41, 0xa6, 0x7d, 0xc5, 0x56, 0x82, 0x9e, 0x86, 0x85, 0x14, 0x6, 0x47, 0x8f, 0x15, 0xff, 0x8c, 0x27, 0xe4, 0x5d, 0x3a, 0xd5, 0xd6, 0x22, 0x33, 0x26, 0x81, 0xa1}}
-	return a, nil
-}
-
-var _blockchainContractsPiggyCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3c\xef\x73\xdb\x36\xb2\xdf\xf3\x57\x20\x9a\x37\x89\x34\x95\xa5\xa4\xed\xa5\x3d\x4d\x5c\xc7\x89\xe3\x3e\xcf\xbb\x3a\x9e\x44\x6d\x3f\x64\x32\xef\x20\x72\x25\xe1\x4c\x02\x3a\x00\xb4\xa2\xfa\xfc\xbf\xbf\xc1\x02\x20\x01\x12\x94\xe5\x36\xd7\x7b\xfc\x62\x8b\x04\x16\x8b\xc5\xfe\xc6\x02\xac\xdc\x08\xa9\xc9\x79\xc5\x57\x6c\x51\xc0\x5c\x5c\x03\x27\x4b\x29\x4a\xf2\xec\xf3\x5f\xe9\xb3\xef\x5e\xbc\xc8\xff\xfa\xcd\xe2\xc5\x8b\x67\xdf\x2f\xbe\x7b\xe4\x1a\x5f\x0a\x9e\x6c\xff\xe2\x9b\xe7\xf0\xfd\xf7\x14\xbe\x5b\x3e\xcf\xbf\xcb\xbe\x7e\xe6\xdb\xff\x04\x9a\xe6\x54\xd3\x5f\x18\x6c\x55\x6f\xe3\x47\x9b\x6a\x41\x32\xc1\xb5\xa4\x99\x26\x57\x6c\xb5\xda\xbd\xa6\xfc\x5a\xcd\xba\xe3\xdd\x3e\x22\x84\x90\xe9\x94\xcc\xd7\x40\x38\xe8\xad\x90\xd7\x44\xaf\xa1\xe9\xce\x14\xc9\x61\x53\x88\x1d\xe4\x44\x70\xd7\xdc\x0c\xb0\xac\x38\xb9\xb4\x3d\x86\x23\x32\x23\x1f\xb4\x64\x7c\x45\x6e\x89\x04\x5d\x49\x4e\xfe\xeb\xf6\xf2\xed\xfc\xd7\x77\xef\xff\xe7\x8e\xdc\x3d\xf2\xc3\x1c\x7d\x99\xc7\x83\x6b\xe6\xd6\x20\xfc\xf6\x06\xb8\x56\xff\xa6\x01\xdf\x96\x4c\x6b\xc8\xc9\x76\x0d\x1c\xe9\x94\xc2\x80\x29\x92\x49\xa0\x1a\x72\xec\x66\x88\x05\x06\x29\xf2\xc6\xb5\xb8\xe0\x4c\x33\x5a\xb0\xdf\x20\x1f\x8e\x1e\x25\x61\x53\xc2\x61\x6b\xa1\x13\x03\x9e\x28\x2d\xab\x7d\xb0\xb1\xe9\x1b\xfb\x69\xc8\xf2\x19\xf9\xf9\x82\xeb\x6f\xbe\x1e\x93\xd2\xf1\xcc\x8c\xdc\xda\x25\x9a\xd9\x3f\x77\xa3\x9e\x81\xcf\x04\xa7\x9a\x09\x6e\xc6\x2a\x69\x0e\x44\x0b\x42\xc9\x06\x51\xa1\x3c\x27\xd4\xcc\xb4\x84\x52\x48\xaa\xd9\x0d\x90\xcb\xf3\x39\x36\x65\xbc\x8b\x95\x87\xf5\x13\x7e\x1c\xe6\xee\xe7\xc5\x99\xc5\xef\xc5\xb7\x63\x0b\xd8\xbf\x30\x08\x2b\x90\x8c\x16\x97\x55\xb9\x00\xe9\xdf\xf6\xe1\xba\x90\x40\xaf\x3d\x76\x0e\x16\xd9\xb2\xa2\x20\x5c\x68\xb2\x00\x92\x43\x01\x1a\xf2\x49\x0b\xaf\x0f\xa0\x5f\x4b\x23\x01\xc3\xd6\xf0\x87\x10\x25\x07\xa5\xa5\x11\x88\x9e\xc9\x9e\xf9\xef\xf5\x3a\xbc\xf8\xb6\x81\x8b\xec\x49\x96\x42\x92\x37\xa2\x28\x20\x33\x3d\x8e\x24\x14\x66\xe1\x08\xc5\x9f\x9e\x7b\x7b\x70\xc9\x03\x5c\xb6\x4c\xaf\x73\x49\xb7\x4e\x75\xd0\x00\x68\x0b\xbb\x5f\x5d\xcb\x00\xa9\x31\x76\x9a\x91\xd3\x3c\x97\xa0\xd4\x49\xdf\xe4\xf3\x68\xf2\x1b\xa1\x98\xf9\xc8\x38\x32\x46\xef\x80\x67\xb6\x65\x34\x9e\x16\xe1\x68\xd8\xe1\x4f\xd4\x0d\x47\x05\xdc\x40\x41\x96\x0c\x8a\x5c\x4d\x02\xd5\xa7\xac\xc2\xa3\x8c\x9b\x15\xa8\x68\x41\x6e\x68\x51\x81\x22\x7a\x4d\x35\xa1\x12\x88\xd2\x42\xe2\x9c\x51\xea\x55\x49\xa5\xae\xc1\x4e\xfe\x0d\x33\xf0\x20\x7f\xa1\x92\xd1\x45\x01\x44\xb1\xdf\x80\xe4\x0c\x09\x4d\xe5\x8e\x88\xa5\xd3\x0e\x56\x31\x58\x96\xa1\x59\x06\x4a\x0d\x15\x14\xcb\x11\xb9\xa1\x12\x05\x82\x81\x3a\xa3\x9a\xaa\x19\xb9\xb5\x3c\x3e\xb3\x3d\x9d\x56\x36\xa3\xfc\x44\x37\x1b\xa3\xbc\x6b\xa0\x17\x67\x6e\xee\x8c\xe7\x2c\xa3\x1a\x49\x01\x84\xa3\x50\x9a\x66\x9e\xd7\x15\x82\xf0\xd8\x62\x97\x35\xbd\x01\xb2\x00\xe0\x86\x6f\x00\x39\x5d\x6d\x20\x63\x4b\x96\x21\x74\x06\x0d\xed\x7f\x4d\xab\x9c\x31\xd1\x6b\xa6\xec\x22\x98\x77\x31\xf5\xeb\xd6\x5a\x78\x38\x6a\x2d\xb6\x84\x69\x45\x36\x05\xcd\xc0\x37\xbc\xb2\x5a\x01\x56\x13\xf2\xfc\x1b\x83\xf5\x8b\x67\x93\x90\x4e\x7e\x01\x2d\xad\xec\xe4\xde\x2d\xeb\xa9\x5d\x81\xbc\x62\xab\x80\x6c\xf6\x6f\x63\xcd\x8c\xd1\xbc\x38\x73\x84\x52\xa4\x52\x90\x1b\x65\x69\x15\x74\x3d\x57\x12\x88\xbe\xdc\x11\xcd\x4a\x20\xd4\xd1\xb9\x51\xe7\xb5\x22\x34\xef\xa8\x52\x6c\xc5\x21\x6f\x08\x2b\x2c\xfd\xbd\x4d\x78\xaa\xcc\xc0\x46\x1b\x6b\x43\x41\xa6\x08\xe3\x99\x84\x12\x8c\x9a\x25\x8b\x1d\x79\xde\x68\x3c\x9c\x1b\x7c\xd6\x2d\x4d\xf7\x28\x6a\xa0\x85\xa6\xc5\x87\x6a\xb3\x29\x76\x5e\x5a\x9b\x06\xce\xf6\x58\x94\x6f\x1d\xdb\x34\x24\xa8\x38\xfb\x67\x85\x94\x30\x6b\x5d\x13\x1e\xed\x56\xdd\xd6\xc0\x29\x40\xb7\xd5\x7d\x04\xec\x83\x59\x66\x45\x68\x51\x20\x14\x6f\xb9\x08\x5d\x88\x4a\xe3\x2b\xab\xe0\x17\xc6\x20\x52\x45\xa8\xc1\xcc\xf0\x6d\x69\xf9\x37\x46\xcc\x10\x45\xa1\x1d\x30\x3d\x0b\xc1\x57\x44\x83\x2c\xc9\x96\xee\xd0\x66\xd5\xe0\xd1\x5e\x2c\xbc\x8c\x4f\xc8\x85\x7e\x6a\x60\x6b\x30\x4e\x17\x95\xbb\x10\x6c\x26\xb8\xa3\xc6\x76\xcd\x0a\x20\x5b\x20\x4b\xb6\xaa\x24\x10\x83\x22\x25\x0b\xd0\x1a\x24\x8e\xa1\x05\xc9\x45\x3d\xca\x24\x80\xd2\x21\x4a\xd7\x46\x3b\x77\xea\x2e\xa2\xcf\x45\x2d\x8c\x6c\x19\xd0\xd9\x30\x51\x25\x25\x70\x5d\xec\xc8\x02\xcd\x5a\x38\x9a\x17\xb2\x2e\xc7\x31\xed\x29\x64\x7b\x85\x9d\x0c\x6f\x35\x42\x6e\x54\x20\x2d\x0a\xb1\xb5\x1c\xbe\x80\xda\x33\x60\x3a\x35\xd6\xc6\x8f\x65\x01\x8f\x03\x50\x19\xe5\xde\x36\x0b\x0e\x51\xe7\x53\x87\x63\x46\x39\xe1\x70\x03\xd2\xb4\xca\xd6\x94\xaf\x20\xb7\xf6\xcd\x82\x33\xe3\x56\xdc\x81\x0e\x01\x18\x9a\xe4\x90\x31\x65\xb5\x43\xed\x1e\xb8\xa9\xe3\x74\x97\x8c\xd3\x62\x12\x2d\x81\x11\x00\x0b\x6d\x46\x5e\x0b\x51\x44\x44\x77\x28\x59\x2b\x67\x4c\x74\x29\x2a\xae\xc7\x35\xd7\xfc\x06\xd2\x60\xa3\x59\x61\x66\xdd\xcc\x39\x9a\xd9\xc5\x25\x79\xf3\xf6\x72\xfe\xa1\x33\x6c\x0d\xf7\x14\xc1\xc6\xb2\x17\x61\x20\xc5\x8e\x16\x7a\x57\x4f\x0b\xe4\x61\x23\xb8\xc6\xef\x6d\xf7\xee\x00\x8c\x33\x3d\xec\x67\xc0\x91\x8b\x11\x6a\xb0\x12\x5a\x6f\xcc\x53\x73\x79\x01\x7c\xa5\xd7\xe4\xf1\x31\x79\x36\x23\x83\xcb\xda\x87\xad\x85\xad\x59\x7e\x28\x37\x7a\x37\x88\x40\xdd\x45\xbf\x8c\x19\x9b\x78\xad\x78\x1c\x18\xf4\x49\xa0\xce\xba\x3d\xea\xa1\x8e\xeb\x51\xbb\x8d\x5a\x74\x27\xc7\xe4\x59\xb7\x51\x4c\xba\xbe\x36\xc8\x91\xc7\x64\x49\x0b\x05\xd1\xf7\x00\xe1\xd0\x0e\x7f\x0c\xe7\xf5\x89\x1c\x23\x9c\x47\x0d\x09\xc2\x55\x45\x0c\x10\xce\x70\xe4\xb8\xd9\xc9\x3d\x51\xc2\x1a\x1e\x2e\x48\x29\x24\xc4\x12\xe6\x25\x34\xa5\x74\x0c\x47\x49\x38\x7a\x23\x78\xce\xb0\xc3\xac\xad\xd0\x9d\x63\xb1\x16\x55\x91\x7b\x47\xba\xa5\x21\x7c\x04\x18\x20\xd8\xc3\xca\xe3\x16\x07\x92\xda\x25\x6e\xf1\x11\x5b\x92\xc7\x21\x49\xbb\x5c\x16\x13\x5c\xcb\x0a\xd2\x4d\xba\x8b\xdb\x7a\xd3\x07\xb9\xb5\xdc\xf1\x8b\x4e\x27\x28\x59\x32\x92\x08\xd7\x77\xd4\xc3\xdf\xc1\x32\x7b\x52\x9a\x00\xca\x2f\x62\x3b\x2c\x19\xd7\x3e\xf8\x1b\x51\x1a\x2b\x5f\x07\xdc\xa3\x19\x79\x65\xac\xd9\x21\x52\x1a\x92\x77\x46\x06\x6f\xac\x28\x96\xf4\x1a\xac\xe6\x6c\xfc\xaa\xc0\xba\x2c\x20\xa3\x95\x82\xc0\xfc\x36\x2a\x6e\x10\x00\x6f\x4d\x34\xfa\x39\x9d\x92\x1f\x41\xf7\xf9\x90\x6d\xb7\x11\x4d\x8b\x75\x25\x98\xb2\x58\xb4\xa1\x19\x03\xa0\xc0\xf8\x00\xd8\xc6\x43\x7a\xaa\x5c\xf4\xe8\x46\x89\xba\x19\x43\xcb\xab\xf2\x82\xdb\x79\xc5\x0a\x25\xed\xfb\x7d\xf4\x52\xfa\xb8\x33\x1f\x13\xd1\xd6\x3e\x99\xef\xd6\x1d\x0f\xb6\xfe\x9b\x5b\xa7\x97\x47\xde\x3f\xbc\x3c\x9f\x0f\xe3\x58\x37\xc0\xee\x2b\xb7\xf0\xc3\xe7\xa3\x71\x67\x1d\xf7\x3f\x35\xeb\xb8\x7f\x3a\xbc\x33\x6b\xbf\x18\x75\x66\x77\xe1\xbd\x49\x97\x0c\x32\x52\x14\x79\xfd\x36\xda\xdf\xb7\x4a\x0f\xa0\x2e\x39\xee\x99\x7a\x8c\x97\x4b\x2c\xbd\x3c\x0a\xa8\xba\x4f\xa0\x56\xb5\x68\x8e\xac\x5d\x6f\x89\x84\x83\x17\x08\xc5\x3d\xd0\x2e\xab\x32\x98\x44\x27\x75\xe0\xff\x39\x49\x8f\x73\x00\x41\x22\xcb\x10\x20\x13\xa0\x14\xf8\xe3\x75\xbe\xc1\x18\xbb\xdb\xb6\x12\xbf\x38\x33\x2b\xd6\xc8\x31\x0a\x59\x14\x3e\x49\x58\x82\x04\x9e\x81\x7a\x90\x97\x6e\x80\x47\x61\xd6\x26\x84\x1f\x48\x23\xd9\xd2\x28\x2d\xe4\xfa\xbf\xd3\x6b\x90\x5b\xa6\x80\x5c\x73\xb1\xb5\x32\x0c\x3d\x72\xeb\xf1\x49\xe5\x84\x22\xa4\x82\x49\x51\x25\xf8\xd8\xc4\x40\x39\x2c\x69\x55\xa0\xd3\x87\xbe\x46\x07\x6a\x9f\x4e\x6d\x39\x47\x07\x65\xa8\x12\x52\xe6\x14\x74\x8b\x1b\x5a\x5e\x4d\xaf\x1b\x13\x0e\x82\x4e\x42\xf3\xb3\xdb\xb8\x3d\x93\xe3\x36\x36\x6d\xce\xb6\x3c\x15\x06\xb2\x12\x94\xa8\x64\x06\x76\x21\x25\x6c\x24\x28\x4c\x55\x45\x3c\x73\x79\x3e\x8f\xb2\x53\x86\x94\x75\xcf\xcb\xf3\x79\x37\xc7\x3c\xb9\xb8\x3c\x9f\x8f\xe3\xd4\xf5\xe4\x3d\x28\x51\x18\x07\x3f\x8e\x26\x7f\x2c\xc4\x82\x16\x3e\xa0\xac\xed\x51\x40\x1e\xbf\x74\x4d\x52\xa9\xfe\x14\x47\x92\x28\x21\xa1\x91\xe9\xf8\x82\x35\x17\xa0\xdf\x1b\x0a\x53\x6b\xfd\xd3\xcb\x7d\x9f\x85\xee\x5b\xff\x8e\x76\x5d\xd9\x49\xff\x24\xf0\xcd\xc5\x99\xea\x53\xa3\x41\x8c\x1e\x5b\xaf\xf0\xc3\x57\x8e\x2c\x1d\xed\x89\x7c\xc2\xf2\xde\x9e\x1d\x1b\xf0\x01\x74\x1c\x86\x5b\xb5\x93\x60\x3e\xeb\x6c\x87\x14\x1c\x76\x8d\x50\x4c\xc5\xf0\xd7\x83\x0d\x14\x3a\x5e\x7b\x52\xcb\x6e\xaa\xf7\x19\xce\x0e\x8e\xf7\xb4\x6f\xcf\xc0\x4d\x3d\x92\xd4\x91\x6f\xdc\x8a\xdc\x97\xb1\x18\x85\x59\xe4\xb1\x9d\x0f\xe5\x2e\x6f\x6a\x82\x6a\x17\xe8\x47\x3c\xad\x05\x11\x95\x56\x2c\x07\x22\x6e\x16\x0a\xe4\x0d\x48\x9f\xa5\x33\xee\x93\x72\x49\xb7\x28\x3b\x6d\x1e\xf7\x66\xd8\xe6\xc4\x88\x8c\x71\xd2\xda\x51\x70\xd4\x13\x96\x9c\xe6\xb9\x0d\x3a\x3c\x6f\x8c\xd1\x13\x80\xcf\xb4\xdc\x14\x30\x69\x24\xd7\xbc\xb5\xe6\xc1\xea\x65\x22\x24\xd9\xae\xa9\x36\xc1\xfd\xd8\x28\x68\xc6\x31\xb1\x90\x48\x93\x78\xbb\xcb\x69\x09\xc6\x80\xfb\xfd\xa5\x94\x69\x1d\xd4\x84\xb5\xf6\x63\xd0\x59\xc9\x49\x26\x78\x46\xf5\x30\xbd\x6a\x13\x2d\x2c\xf8\xe1\x68\xd4\xdb\x75\xd0\x4c\x66\xcb\xf4\x9a\xb0\x9c\xcc\x06\xfd\xcd\x1f\x30\x52\xc2\xdb\xc8\x41\x65\x92\x6d\x30\x16\xb8\x67\xf2\xcd\x40\x2d\xa9\xf1\xc1\xf8\x0f\xe4\x19\x39\xe9\x6f\x46\x4c\xa0\x2e\xdc\x02\x0d\xfa\x56\xbc\x28\x88\xaa\x36\x1b\x21\x8d\xdb\x57\xab\x84\x1b\xbb\x11\xe9\xd2\x7e\x4e\x85\x31\x9e\x15\x55\x6e\xf0\x35\x2f\xdf\x18\x3e\x31\x9e\x2f\x6a\xfe\x94\x5b\x85\x1f\xcc\x2c\x3f\xce\x77\x1b\xf8\x94\x9e\xe5\xc7\x0e\xa1\x4d\xe3\x97\xb1\x59\x39\x63\x6a\x53\xd0\xdd\x0f\xc3\x84\xd3\x9c\x68\xfe\xf6\xb3\x06\xc9\x69\xf1\xf3\xfb\xbf\x1d\xda\xe5\xf2\x7c\xde\x6c\x78\x3c\x70\xb8\x0f\xc8\x07\x87\xb6\xfe\x09\x72\x46\xd5\x0f\xc3\x98\xc3\x3e\x25\xd6\xa7\xcb\x3d\xd2\x9a\x57\x03\x68\xf8\xbf\xb8\x48\x33\x1c\x62\x34\x23\xa7\x7c\x67\x8d\x63\xdb\x4b\x55\x5b\xa6\xb3\x35\x36\x4e\x84\x8e\x19\x55\xb0\x9f\xe2\xb3\xa4\xf6\x74\xab\x97\xec\x34\xec\xd5\xb7\x46\xe6\x9d\x12\xb2\xe2\xdf\xaf\x9a\x03\x41\xf1\x3a\x39\x14\x9d\xfe\x8e\x7a\x5d\x95\x0b\x4e\x59\x31\x6b\x61\xf7\xdf\xf3\xf9\xd5\x39\x2b\x60\x58\xc9\xc2\x81\xac\xdb\xa6\xf4\x83\x79\xba\x6f\xfb\x08\x56\x33\xc1\x03\xe8\x65\xfb\xf4\x93\xcb\x59\xfc\x1e\x8b\xf4\x07\x11\x8e\x85\xe4\x01\x58\x07\x1d\x2d\x6a\x2b\xa8\xf3\x1b\xe6\x5d\x82\x94\x7d\x38\xf4\x48\x5d\x1a\x19\xe3\xd3\x2d\x28\xe7\x20\x2f\x4a\xba\x02\x72\x4c\x12\x82\xd5\x4f\xcc\x25\x2b\xa0\x97\x25\xf6\x7a\x08\xc8\x2f\x83\xb5\xd6\x1b\x35\x9b\x4e\x57\x02\x6d\xc5\x24\x13\xe5\x54\x69\xaa\x59\x36\x65\xe5\x6a\xaa\x44\x09\x47\xcc\x20\x36\x51\x37\xab\xae\xa1\xaa\x17\xa8\x9f\x73\x4b\x33\x01\x43\xa5\x19\x19\x20\xa4\xa9\xba\x59\x7d\xf5\xb9\x2c\xd2\xd0\xd2\x0c\x80\x51\xd5\x3f\x2b\x2a\xe1\xff\x39\x95\x36\xfc\x4b\x51\xa9\x17\x52\x9a\x42\x49\xa6\x4e\x71\xe2\x7d\x7a\x6c\xd0\x38\xdc\x83\x03\x15\xd9\x20\xa8\xfc\x60\x0a\x37\x15\xd7\x94\x67\xe0\xff\x5b\x01\x31\x24\x12\x1c\x48\xc1\x96\xe0\x36\xa3\x76\xa2\x32\xf6\x94\x50\xdc\x39\xc5\x6d\x63\x63\x78\x5d\xd1\x46\x0d\x40\x2c\x70\xa7\x1b\x1d\x38\xbd\xa6\xdc\xf4\x93\x64\xc5\x96\xfa\xf1\xe3\xc7\x7b\x30\x84\x46\xa0\xdb\x4b\x1f\xca\x7a\x6a\x6d\x07\x7b\x16\x2a\xe0\xc2\x59\xf8\xa3\xbf\x47\x20\xdd\xb3\xf0\xc7\x9e\x31\x44\xc6\x68\xa1\x66\x09\xcb\x16\x3e\x03\xbd\x65\x5a\x83\x1c\x1c\x34\x3f\xd7\x18\x79\xd7\xcd\x75\xdf\x3c\x71\x80\x9c\xa9\x4c\xc8\xfc\xb0\x01\x5c\x63\x1c\x80\xf1\x1b\xa6\xe1\xe0\x71\x18\x57\x9a\xae\x24\x2d\x0f\x1b\x69\xbb\xdd\x4e\xea\x2e\xf1\x84\x7a\xc7\xb9\xfb\x83\x86\xa5\x76\x70\x1e\x60\x53\x6c\x9f\x7e\x81\x63\x1a\x4a\x35\x4b\x38\x8b\xe1\xf3\x20\x45\xe7\x9f\xdf\xaf\xf0\xfc\xd3\xb8\x13\x46\x35\x55\x25\x2a\xa5\x61\x3f\x7d\xfd\x73\x40\x02\xb8\xab\xeb\xfe\xb1\x81\x3d\x6a\xf3\x00\xb0\xff\x71\x22\xdd\xb0\x1c\xc4\x97\x27\x0f\x82\x9d\x96\x9b\x6f\xef\xa1\x4e\xef\xd7\x4f\x07\xb0\xfd\x5d\x32\x71\xcd\x59\xd1\x13\x5d\xad\x40\xdb\xd8\xe9\xe7\xf7\x7f\x8b\x62\xfe\xf7\xd8\x53\xcd\x30\x43\x97\x89\x72\x53\x99\xf0\xcb\x2b\x62\x43\x2d\x9f\xe4\xcd\xdb\x09\xf1\x20\xc0\x8a\xbc\xae\x7b\x22\xe9\x94\x61\xf6\xb0\xa7\x83\x28\xb8\x65\xf9\xa1\xd1\x2c\x55\x0a\xf4\x15\xd5\xeb\x83\x87\xc7\x1e\x6a\x12\x62\x81\xab\xf8\x60\x14\xa6\x53\x07\x5b\x11\x8a\xf4\xd2\x82\xe4\x4c\x5d\xb9\x72\x46\x62\x65\x11\xcb\xab\x72\x82\xb2\xd3\xc1\x3e\x12\xd7\x1e\xfc\x8d\x3b\x65\xa0\xdb\xdd\xdc\x49\x30\xe1\x3a\x7d\x70\xb2\x65\xb9\x5e\x1f\xff\xe5\xf9\xd7\x2d\xa5\x1a\xc6\xf1\x74\xb3\x01\x9e\xbf\x43\x37\x80\x16\x57\x54\xd2\x52\xd9\x40\xa4\x92\xc5\x98\x2c\x99\x54\xfa\x0c\x0a\x56\xce\xc8\xe0\xc9\xa0\x6f\xc6\x89\x99\x36\xf1\x8e\x59\x0d\x63\x0e\xb1\xc0\x8f\xe9\xf5\x7e\xf6\x09\x42\x9f\x3f\x3c\xf5\xaf\xff\xf2\xe2\x3f\x32\x75\x94\xf9\x87\x4d\xdb\x69\x9f\xdf\x3f\xe5\x29\x42\xf8\x42\xd3\x3d\xe9\x9f\x2e\x42\x51\xe8\xe3\x09\x07\xab\x2e\xe4\xde\x18\xa0\x84\x03\xe4\xb6\x6c\xc7\xa5\x05\x5c\x56\x37\x67\xb4\x2b\xa8\xbd\x48\x59\x3a\xc4\x78\xd5\x3b\xd0\x49\x22\x4d\xa7\x6c\x49\x86\x41\xb2\xb9\xa9\x16\x3f\x3e\x26\x03\x0d\x4a\x73\xd0\x83\x44\x7e\xbc\xa1\x54\x25\x0b\x4f\xd1\x66\xe0\x86\xc8\x35\x8c\x16\x84\xbb\x14\xd5\x2b\x19\xaa\xdf\x86\x94\xf8\xef\x23\xe2\x33\x9b\x25\xc3\xf4\x2c\xb5\x45\x8b\xb4\x20\xb4\xd2\x6b\x21\xd9\x6f\x7e\x7f\x29\xdc\x26\xf1\xdd\xb0\x36\xca\x6e\x95\x88\x2d\x07\x69\xa8\xbd\x01\xb9\x14\xb2\x24\xb6\x76\x9f\x72\x6d\x28\x6c\xeb\x7b\xcd\xe7\x52\xe4\x6c\xb9\xc3\x2e\x1e\xca\x0d\x95\x4c\x54\x8a\x50\x33\xb4\x56\xe1\xfe\x1d\x03\xbb\xc6\x9e\x61\xfb\x37\x61\xec\x0c\xe2\x7d\x15\xbb\xe3\xec\x2a\x99\xf0\x7f\x15\x55\x98\xbb\x0d\xc5\x88\xb1\x78\x6e\x0b\xe2\x14\xd6\x4d\xf1\x08\x93\xa0\x0e\x95\x75\xea\xe0\xe3\xe2\xd8\x00\x66\x08\x1e\x59\x0b\x34\x48\x35\x0b\xaa\xdf\x4e\x43\xc8\xae\xa8\xaf\x49\x36\x6a\xa6\x0b\x50\xae\x3a\x81\x49\x12\xed\xe6\x58\xb6\x49\x3c\x2e\x29\x3d\x23\xb7\x83\xb9\x78\x0d\x83\x19\x19\x9c\x61\xde\x39\x1f\x8c\xc9\xe0\xd7\x35\x70\xf3\xea\xf4\xc3\xe9\xd5\xe0\xae\x07\xd9\xda\x0a\xeb\x68\x5f\xb5\xa1\x9f\x58\xfc\x03\xd2\x53\xf5\x82\x15\xac\xc0\xbe\x62\x2b\xbf\x9b\xd4\x95\x09\x5b\xda\x1f\x8f\x1b\xb5\xb1\x65\x9e\xdb\xa8\xb8\x21\x18\xca\xff\x37\xea\x28\x32\x0e\x5b\xdc\x89\xf4\x9d\xfd\xe6\xe4\x3d\x45\x01\x17\x67\x75\x09\x12\x16\xd6\xf1\xa7\xda\x56\xc0\xd2\x15\x65\x71\x25\x44\xba\x72\xab\xb7\xa4\xab\xbf\x02\x00\x77\x2c\x3a\x27\x1d\xda\x88\x87\x67\x1e\x9a\x69\xb7\x67\x83\xc5\xa6\x01\x6f\xd7\xc7\x37\x0c\xd7\x87\x6e\x00\xd9\x53\xca\x85\xb4\xfb\x14\x10\x2f\xed\xf8\x99\x56\x29\x05\xde\x94\x51\x09\x29\x85\x85\x90\x28\x2b\x78\xe2\x2b\x6f\x43\xd8\xe9\x12\x9f\x3e\x44\xeb\x52\x8b\xc7\xc7\xc6\x0f\x6d\xaa\x7f\xec\xc8\xb6\x9f\x2b\x0c\x27\xb9\x00\x5c\x4e\xf8\xcc\x94\xde\x57\xa1\xd7\xa6\xe9\x8f\x98\x7c\xa8\xeb\x0a\x7c\x21\x91\xdd\xd7\x32\x1a\xc5\x11\x84\xe9\x76\xcf\x4a\x01\xf9\xfb\x93\xbf\x47\x9b\x5f\x1a\x77\xa5\x5b\xb0\xac\xa8\xd9\xd2\xe7\xdd\x06\x52\xe4\x1e\x3e\xb9\x97\x0a\x54\x39\xa2\x9e\x8c\x1e\xf7\x58\x56\x2b\xb1\x97\xb0\xb5\x0a\x35\x56\x9b\xf6\x9d\xd7\xb9\xf7\x0b\xbe\x07\x63\x3c\x8a\x57\x5e\x43\x27\x50\x7f\x79\xe4\x8a\x83\x5c\xeb\xa4\xc1\x0a\xcb\x9b\x0d\x49\x18\xd7\x20\x97\xd4\xdb\xa4\x4a\x81\xb4\x45\x80\x19\x55\xda\xe9\xca\xa6\x72\xa7\x49\x5f\x11\x5a\x1f\x93\xd2\xc2\x9a\x30\x22\xf4\x1a\x77\x15\x85\x3f\xe8\x11\xf4\xc4\x03\x1f\x16\x5c\x03\x64\x42\x2e\x34\xa1\x85\x12\xde\x06\x2e\x85\x24\x12\x68\xee\xeb\xb1\x5d\x59\xee\xc5\x99\x8a\x2b\x88\x9c\xd8\x05\x90\xba\xb6\xac\x99\xd9\x59\xbd\x67\xe5\x9b\x5f\x55\x8b\x82\x65\x01\x19\x9b\xad\x33\x7b\xee\x44\xdb\x32\xb7\x57\x9d\x82\x84\xcb\xf3\xf9\xa8\xd3\x6b\x41\x75\xb6\x3e\x0b\xbb\xaa\x54\xdf\x66\xfc\x2e\x88\x15\xe8\x8b\x33\xbb\x91\x65\x33\xf2\x9f\xba\xa3\xa0\xb4\x5d\x9e\xcf\xc3\xa3\x41\x33\xf2\x24\x85\x63\x4f\xe7\xba\x44\x30\x86\x10\xfa\x59\xe7\xf3\xf6\xe6\x4e\xb3\x05\x2d\x41\xd9\x9a\x18\x23\xe0\x9c\x15\x63\xcb\x42\xb9\x37\x6a\x96\x11\x21\x6f\x24\xaf\x0d\xc8\xd5\x85\x2e\xac\x80\x2a\x5a\x82\xaf\xe1\xa1\x72\x55\x95\x6e\x0b\xdb\xfc\xf6\xfe\x4e\xac\xb7\x84\xd2\x09\xc5\x35\x74\x88\x1d\xa3\x7e\x1a\x91\x7f\xfd\xcb\xbf\x3a\xc1\xaa\x85\x63\xc2\xf2\xd1\x8c\x24\x83\xef\x96\x2e\xeb\x16\x38\xcd\x5a\x15\x51\xdd\x49\xba\x23\x14\x42\x4a\xc8\x7a\x55\x5e\x5b\x08\x03\x51\x42\x8f\x31\xf6\x0d\x01\x8f\x7b\x18\x69\x24\xdb\xb5\x30\x7e\xa1\xc2\xf2\x99\xda\xdd\xc3\x0a\x72\x65\xcd\x10\x77\x82\x45\x33\x5b\x6c\x67\x1c\x44\xca\xe9\x0a\xdc\xfb\xcb\x73\x57\xda\x9d\x72\xf9\x1a\x3c\x66\xbd\x82\x32\xee\x16\xe6\x5c\x49\x61\x82\x14\x99\xf8\xf4\x1e\x32\x60\x37\xc9\x4f\x5d\xc0\xe9\xd2\x9e\x80\x38\xb7\x91\x67\x79\x16\x1d\x62\x0a\xca\x96\x05\x37\xfe\x32\xee\x1b\xa3\xfc\x85\x9d\xdc\x21\xc3\x90\xc6\xbb\x0d\xd8\x40\x8e\x3a\x31\xc0\x13\x28\x0c\x8a\x3c\x92\x1c\xe3\x17\x19\x9f\x3c\x37\xb4\x9f\x91\x57\xb7\xb6\x71\xa2\x50\xe9\xf2\x7c\x7e\xd7\x2a\xfd\x49\x16\x6f\xd5\xd0\xc8\xcb\x23\x72\x9b\xac\xe9\xc5\xd5\xb5\xe7\xed\x88\x84\x52\xdc\xa0\xfb\xde\x70\x26\x1e\x66\x88\xd5\x1f\x9a\x34\xdb\x92\xd5\x12\x94\xd1\xa2\x08\xea\xbd\xfa\x7d\x68\x3f\x9a\xf1\x1b\x62\x5e\x37\x94\x0b\x7b\xf9\xd3\x4a\xf6\x18\x87\x45\x2e\x4f\x21\xd4\x33\xaa\xf4\xce\x70\x52\xb1\x22\x0c\x6d\x0f\x69\x98\x81\xb6\x34\x38\xa3\xd8\xd1\x68\xfe\xcb\x30\x44\xbf\xd6\x69\xe9\x01\x6e\x3b\x9e\xdc\x6b\x2b\xf6\x7c\x69\xdd\x82\x6c\x0d\xd9\x35\x61\x4b\x52\x88\xec\x3a\x28\x83\x21\xde\xdf\x5d\x6a\x1f\xb8\xc7\xea\xb8\x41\xa2\xeb\x2d\xbe\x47\x3a\x59\xf7\x7b\xa9\xf7\x92\xcb\x8f\x63\xa9\xf0\xf2\xa8\xc5\x34\x13\x4b\xf2\xe1\x35\xec\xa2\x21\xbb\xaa\xed\xe4\x84\x6c\x28\x67\xd9\xd0\xeb\x37\xdf\xba\x91\x72\x74\xd5\xb0\x44\x1f\x7d\xb5\xc6\x97\xf5\x58\x0d\x52\x1e\x74\x74\x14\x14\xd1\x9c\xb0\xdc\x1f\x06\xad\xb1\x95\x27\x13\x6a\x8f\x6a\x8e\xf6\x3a\x7d\x36\x3a\xc2\x71\x9b\xd3\xa8\x3a\x2a\xa7\x25\xa1\x87\xa3\xfb\x2a\x6d\xa7\x53\x6b\x85\x3d\x7a\x35\x38\x45\xca\xaa\xd0\x6c\x53\x38\xd6\x52\x81\x2f\x89\x96\xa7\xb4\xa7\xc1\xee\xe5\xdd\x50\x62\x58\xae\x66\xe4\x94\x13\x2a\x25\x45\x4d\x84\x67\x1e\x45\x3d\xe8\x7d\xb1\xe0\x3e\xdf\xc0\x44\xb2\xcd\x1a\x58\x49\x70\x07\x4c\xd5\xfd\x11\x6b\xe7\x89\x49\x5b\x62\xa6\x58\xed\x73\x3a\x23\x32\x0e\x71\xa6\xde\x25\x49\xca\x55\xa8\xab\x7b\x02\x4f\xeb\xf7\x62\x1d\x6d\x1f\xd3\xe3\x69\x23\x33\x72\x00\xae\x29\x77\x6f\x5e\x0e\xf7\xf3\xd3\x85\x06\x69\xdd\x7f\x29\xaa\xd5\xda\xf9\x27\x76\xcd\x6b\xa5\x8a\x8b\x7e\x9f\x14\x1a\x57\x94\xe1\xb1\x51\xd3\xbf\xeb\x73\xb4\x90\x9d\xb4\x3c\xc7\x97\x47\x28\x0b\x49\x25\xc5\xf2\x51\xdf\xd1\x8e\xd4\x9c\xfa\x65\x44\xa5\x85\xa4\x85\x5a\x8f\xb8\x78\x1f\x5d\xd3\x6b\x0c\x4d\x5c\x01\x16\x6e\xf8\xe6\x79\x68\x48\x1a\x50\x61\xf6\x66\x9f\x98\x58\x29\x71\xa4\xf0\x86\xc4\x1a\x8d\xf0\x0c\x78\x4b\xdf\xec\xe3\xca\x83\x1c\xf3\xd6\x32\x75\xb8\xd1\x85\x34\x01\x0e\x56\xcf\xa2\x02\x08\xb2\x50\x0e\x5b\x3c\xdc\xa2\x2a\xd9\xf1\x62\xed\x61\x40\x8b\x3c\x3a\x7d\xdd\x70\x32\x52\xe2\x7e\x94\xc7\xe4\x55\xec\x6a\xa7\x4e\xba\x34\x56\x10\x0f\xea\x76\xa0\x62\x19\xae\x57\xbc\x1d\x00\xa7\x79\x5e\xe7\x79\xb4\x3f\xef\x88\x73\xee\x2e\x9c\x87\x28\x8a\x7c\x9e\xb6\x37\x1f\x59\xfe\xa9\x9e\x40\x67\xac\x77\xbc\xd8\xb9\xfa\xd3\x9a\x9b\x6c\xb8\xee\x8e\x98\x06\xb2\xdc\x21\x21\x06\x71\x94\x7b\xa7\xf5\xa9\x4a\xe6\x4e\xd8\x32\x65\x53\x5c\x22\x22\x21\x95\xb6\x2c\x35\xb8\x3c\xc0\xd8\x26\x2d\x0e\xb0\x4c\xdd\x53\x47\xae\xaa\xd5\x66\x21\x50\x77\x89\x22\x6f\xfb\x27\x03\xe7\x04\xc5\x6e\xbf\xab\x9a\xad\x29\xbb\xcf\x62\x9d\xb5\xe4\x30\x20\x9a\xcf\x57\xa0\xff\xe7\x03\xa4\x76\xa2\xd5\x51\x5e\x11\xa0\xd9\xda\x5b\x0a\xc8\xad\xd7\x6b\xa3\x6e\xa6\x52\x5a\xee\xf7\x47\xaf\x09\x3f\x0a\xb3\x37\x81\x41\xf4\x61\x7b\x4b\xe8\x3b\xbc\x77\x0d\x3b\xe5\xf9\x59\x4d\x7c\x14\xdc\x4d\x1f\x26\xf4\x3a\x76\xed\x28\x91\x90\x26\x96\x24\x82\x43\x47\xb3\x5f\x03\x66\x9f\x11\x44\xcf\x59\xc1\x8e\x42\x77\x38\x26\x55\xfa\x35\xec\x3a\x3a\xfd\x7e\x7e\xea\x31\x3d\x9e\x7b\x5a\x3a\xbe\xb3\xed\x6b\xe8\x5b\xef\x4f\xa6\x68\xef\x2f\xa0\xe8\xa4\x4b\x0e\x48\x3f\xec\xa9\x17\x6e\xdc\x51\x43\xbf\x3e\xde\xf6\xee\xb1\xf7\x7a\x08\x75\xef\xa2\xd0\x19\xaf\x01\x69\x4a\xdb\x7b\x11\x9d\x4e\xeb\x8c\x71\x13\xdb\x60\xa2\x4a\x02\xcd\xf1\xfa\x86\x40\x57\xee\xf3\xdc\x52\x31\x8e\x16\x58\xfb\x14\x27\x0d\x97\xa2\x2f\x78\xaa\xfd\xb8\xd3\x6e\x8e\x31\x4c\xbe\xc4\xbd\x2e\x85\xc6\x64\x02\x53\x44\x18\xcd\x19\xec\xfb\xb8\xe9\xe0\x36\x1b\xcd\x5b\x5b\x05\x97\xe7\xf3\xe8\xa0\xba\xf1\xd8\x29\xdf\x45\xdb\x26\xfe\x8e\x0c\xac\xe0\x24\x57\x05\x50\x05\x98\x17\x8d\xf3\x3e\x66\x84\x38\x1e\xa3\x79\x10\x0f\xec\xbb\x6a\xe0\xa1\x19\xa8\x34\xff\x0c\x9f\x24\x0c\x0c\x55\x69\x10\xfd\xf9\xd5\x0f\x74\x09\xfe\x9e\x04\x97\xb8\x69\xa5\x8e\x29\xb7\x0b\x6b\x18\xa6\x8e\x73\x30\x22\x0a\xe1\x9c\x16\x4a\x10\x2e\xb6\x64\x43\xa5\xae\xe9\xdd\xc9\x75\x60\xae\x22\x4c\xd8\xf8\xd4\xe2\x9f\xcf\x70\xbc\xd9\xa9\xed\xb0\x5e\x0e\x8a\x49\xab\xf9\x9b\x2b\x06\x8c\x9d\x74\xe6\x78\x43\x95\x82\xdc\x60\x12\x47\x7e\xfd\xeb\x6c\xe8\x7c\xc0\x5a\xb7\x33\x86\x26\x7e\xb6\xc1\xf2\x7b\x58\x92\x63\xf2\xa0\x45\x4f\xe8\x63\xbf\x15\x82\xf0\xf6\x78\xce\xf7\xd6\xca\xb4\xa4\x41\x1e\xac\x99\x0e\xd5\x43\xf6\xca\x11\x9e\xe3\x07\x52\x82\x5e\x8b\xdc\x5d\xb6\xd6\xba\xec\x62\xbe\x06\x7b\x59\x85\x3d\x1f\x6d\x93\x2a\xb5\x2e\x4b\x9e\x7c\x8a\xb4\x80\x90\xa8\x04\xa2\x63\x9a\xee\x28\x45\x1e\x0a\x74\xa7\x74\x81\x1d\xb2\xad\xfa\xa7\xaa\xc9\x2f\x90\xa3\x0e\xfd\xc4\x86\xc7\x7a\xdd\x44\xc3\x9d\x12\x59\xb3\x4f\x21\xd1\x4a\xaf\xef\xd7\x4a\x2d\xc6\x33\x20\x8d\x97\xdf\x42\x36\xe6\x58\x02\x85\x4a\xed\xbe\x25\x98\x97\xdc\x77\xec\xdf\x52\xec\x17\x06\x5b\x9f\x39\x6d\x51\xed\x94\xef\xde\xbb\xa4\xe7\x6d\x3a\xd5\x7a\x97\x28\x4f\xb1\x59\xae\xdf\x45\x1a\xd2\x01\xd6\xd0\xc2\xb0\xd0\xb1\x4d\xb5\xdd\x43\xa4\xce\xa9\x67\x2c\x45\x56\x07\xcd\xa7\x47\xf4\x2f\x96\x84\x12\x2d\x29\x57\xf6\x9e\x34\xef\x65\xa9\x76\xa4\x62\x9d\xee\x71\x6c\x26\x0a\xcf\xb4\x2a\xf0\xb0\x8d\x38\x99\x20\x06\xef\xb7\x51\xa2\x39\x3d\xf7\x38\x25\x10\x7d\x27\xe9\xbc\xb7\x17\x13\xfb\x51\xbc\xfe\x7f\xe6\x2d\x88\xfe\xa6\x33\xb7\x01\x63\x4f\xda\xb1\xb0\x70\xe4\x4b\x23\x61\xb3\x3c\x6f\x8d\x4f\x1c\x2c\x44\xb4\x6b\x3a\xee\xb8\xcc\x3e\x3a\x72\xea\xd8\xc3\xa2\x76\xd7\xc4\x68\x56\x15\xef\xd2\x07\x3b\x24\x2e\xd4\xac\xaf\x12\x7b\x87\x9a\xc9\x68\x64\xbc\x49\x22\x0a\xc2\xea\xce\xae\xd3\xd8\x36\xc4\x55\x5f\x60\x56\x91\x48\xbb\xe3\xe1\xa1\xd9\x4c\x8a\x8d\x4d\x1a\x86\xab\x2f\x2e\x7b\x44\x3a\x5b\xbc\xad\xa9\x0f\x0f\x4f\xb4\xb5\xf7\x7e\x03\xa1\xea\x24\xcd\x1a\x26\x5a\x81\x3e\x2d\x0a\x5f\x91\x53\x9b\x41\xc7\xe6\x6e\xdb\xdb\xe0\xdf\x80\x0b\x91\x6f\x79\x24\x75\xe0\xd1\x06\xd0\xba\x9b\xa3\x7d\xf9\xa4\x0b\x3c\x1a\x4c\x30\xfe\x08\x66\x80\xff\x7e\xea\xce\xb6\x67\x8b\x7e\x62\x2f\xde\xeb\xce\x15\xdb\x1b\x75\x81\xb7\x1d\xb4\xa7\xdb\x5c\x15\xd6\xb2\x94\x34\xbe\x7b\x6e\xe7\x21\xd6\xb2\x13\x18\xcc\xba\xf0\x62\x1e\xed\x88\x06\xd7\x27\x30\x45\x16\xc0\xf8\x8a\x28\xa0\x32\x5b\x3b\x3a\x24\x28\x3a\x8f\x71\x22\xd4\x97\xc8\x69\xe1\xff\xf3\xe5\x4d\xde\x17\x6c\x93\x34\x9a\x70\xa2\x28\xa4\x5d\x3b\x74\xd2\xa5\x71\x7d\xd5\x40\xa7\x00\xe2\x64\x12\x1d\x87\xef\xa7\xf4\xeb\xdd\x39\x83\x22\x4a\xb6\xef\x23\xb6\x07\xd3\xf3\xd4\x6b\x81\xdb\x74\x9e\xc0\x11\x2a\x5f\x7c\x6d\x22\x04\x70\x5c\x0b\xc4\xa2\xa0\x85\x6b\x5f\xfb\x40\xf7\xad\xa6\xed\x17\xae\xe9\xbb\x43\x56\xd0\x11\xb2\x7b\x99\x84\x43\xa9\x55\x4b\x79\x12\x5f\xe8\x71\x26\xf8\x53\x6d\x50\xcc\x00\xa3\xa4\x1b\x90\x75\x86\xce\x57\x4d\x09\xe9\x70\xc3\xbc\xdc\x0d\x2d\x58\x93\xaa\x71\xde\xfc\xa6\x7b\xf3\x4d\xba\x3e\x26\x19\xf8\xd9\x52\x6c\x4f\x88\x8f\x38\x58\x70\x1e\x35\xe5\x14\x25\xbd\xf9\x3f\xcb\x18\x32\x7f\xcd\xae\xdb\x73\x0d\xab\x11\xbe\xf8\xc0\xf8\xa7\xb3\x67\x8c\x55\x73\xfe\xb2\xdf\xa6\xc4\xcc\xde\x40\x5a\xb7\xea\xc8\x29\x39\x0e\xf7\x95\xed\x39\xd8\xf4\x95\x31\xa9\x96\x51\x89\xdd\xf3\xf8\x63\x7c\x87\xc4\xb3\xf8\x9e\x39\xbc\xc1\x90\xc3\xb6\x65\x3a\xdb\x49\x5d\x5b\xbe\x6c\xcd\xf0\x44\xd1\x1b\x78\xf9\xaa\xe9\xf0\xc3\x30\xbd\xe5\x63\x53\xb8\x53\x07\x6b\xda\x2d\x53\x18\x45\xb8\xd4\xbb\x4e\x1b\x5b\xe6\x93\xd1\x0d\x5d\xb0\x82\xe9\x5d\x7d\xda\x3c\x91\x61\x8a\x30\x2b\x18\xbf\x7e\xf9\xe4\xb6\xaf\x20\xe2\xee\x87\xe1\xd4\x02\x4f\x20\x33\x26\x9a\xca\x15\xe8\x07\x60\x7c\xe5\xae\xa8\xc4\xfb\x28\xe4\x81\x74\xc3\x32\xaf\x90\x64\xae\xee\xab\x45\xad\x86\xa9\xf1\x7b\x30\x30\x26\xcb\xd3\xf7\x4a\x3b\x31\xbb\xfb\xbf\x00\x00\x00\xff\xff\xf2\x3b\x12\xfa\x99\x5c\x00\x00")
-
-func blockchainContractsPiggyCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainContractsPiggyCdc,
-		"blockchain/contracts/piggy.cdc",
-	)
-}
-
-func blockchainContractsPiggyCdc() (*asset, error) {
-	bytes, err := blockchainContractsPiggyCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/contracts/piggy.cdc", size: 23705, mode: os.FileMode(0644), modTime: time.Unix(1677641323, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0x6d, 0x5f, 0xd8, 0xfa, 0x2b, 0x7b, 0xc8, 0x2c, 0x4c, 0x42, 0xb3, 0x9a, 0x87, 0xd2, 0x7f, 0x64, 0x71, 0x64, 0x43, 0x5d, 0xb2, 0x9d, 0xde, 0xbc, 0x86, 0x66, 0xd9, 0x51, 0x78, 0x9d, 0x58}}
-	return a, nil
-}
-
-var _blockchainTransactionsUserSetup_accountCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\x41\x6f\xda\x40\x10\x85\xef\xfe\x15\xaf\x97\x08\x24\x82\x7b\x8e\x48\x54\xa7\x18\x84\xda\x10\x14\x68\xaa\x1e\x87\x65\x30\x2b\xcc\xae\xb5\x3b\x0e\x41\x11\xff\xbd\xb2\x8d\x31\x24\xb4\xe1\xc0\xc5\xef\xcd\x7e\xf3\xd9\xab\x37\x99\x75\x82\xb1\x35\x83\xdc\x24\x7a\x9e\xf2\xcc\xae\xd9\x60\xe9\xec\x06\x5f\x5f\xc7\x8f\xe3\xc1\xaf\xf1\x70\x74\xff\x33\x9e\x3d\xfe\x88\xc7\x51\xbf\xff\x14\x4f\xa7\xc1\xa1\x36\xd1\x49\xb2\xbb\x27\xb3\xf6\x75\x61\x32\x1a\x0e\xff\xbc\x4b\x3d\xb0\xd0\x82\x84\x9e\x35\x6f\x8f\xc1\x87\x78\x16\xf5\xa3\x59\xf4\x3c\x8a\x7f\x4f\xeb\x42\x10\x86\x98\xad\xb4\x87\x38\x32\x9e\x94\x68\x6b\xe0\x59\x3c\xf2\x0c\x64\x40\x4a\xd9\xdc\x08\xc4\x22\xf7\x5c\x1d\x8f\xf2\xfc\xa2\x39\xdf\xc1\x8b\x75\xda\x24\x45\x96\x37\x99\xec\x20\x5a\xad\x59\xa0\x6c\x9a\x72\x35\x8e\xcc\x02\xca\x31\x89\x36\x49\xd1\x22\x64\xf9\x3c\xd5\x0a\x8a\x32\x9a\xeb\x54\xcb\x0e\x4b\xeb\xa0\x25\x38\xa5\x78\x0b\x02\x00\xc8\x1c\x67\xe4\xb8\x45\x4a\xc9\x0d\xa2\x5c\x56\x51\xc5\xd4\xae\x13\xc5\x2f\x0c\x31\xd0\xce\x4b\x07\x6a\xc5\x6a\x5d\xf0\x7a\x66\xe8\x25\x08\x1b\xbb\x61\x73\x4e\x94\x3a\xa6\xc5\x0e\xfc\xaa\xbd\xf8\xe3\x90\x22\xad\x94\x74\xe7\xd6\x39\xbb\xed\x5d\x35\xb2\xbb\xdf\x8f\xe5\xbb\x56\xe1\xf3\x06\x61\xb1\x39\x25\x1c\xf6\xad\xa1\xe2\x41\x13\x69\xe3\xf6\x16\x46\xa7\xa7\x80\x07\xc8\xd2\x03\x83\x60\x78\x8b\xba\x09\x34\xdd\xb3\x42\x7a\x2e\xb2\x77\x7d\xf2\xfe\xbb\xd5\xa8\xb8\x90\xde\xd4\x5b\x6d\x90\xff\x82\x6f\x17\xd1\x3f\xd0\x4c\x72\x81\xac\xb8\x84\x69\x62\xd0\x06\x87\xe5\xce\x0a\xa5\x1b\x4f\x2f\xdc\xea\x5d\x37\x54\x1d\x88\xfd\xbf\x8d\x7f\x4b\xb8\xfc\x1d\x14\x44\xea\xb2\x90\x12\x21\xd5\x66\xdd\xbb\x7a\x7b\x7f\x83\x4e\x16\x9d\x94\x73\x3b\xa7\xb6\x3e\x72\xd5\xa1\xb3\xcb\xd2\x7d\x62\x6f\xd3\x17\x76\x4d\x6e\x7f\xd7\x0a\x2b\xd0\x0b\xcb\x75\x20\xe4\x12\x96\x4f\x0c\xd4\xfc\xfb\xa0\xfa\xdf\xff\x0d\x00\x00\xff\xff\x83\x16\xd0\x42\x06\x04\x00\x00")
-
-func blockchainTransactionsUserSetup_accountCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainTransactionsUserSetup_accountCdc,
-		"blockchain/transactions/user/setup_account.cdc",
-	)
-}
-
-func blockchainTransactionsUserSetup_accountCdc() (*asset, error) {
-	bytes, err := blockchainTransactionsUserSetup_accountCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/transactions/user/setup_account.cdc", size: 1030, mode: os.FileMode(0644), modTime: time.Unix(1677637060, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfe, 0x57, 0xa8, 0x6d, 0xaf, 0x98, 0x65, 0xde, 0x35, 0x5d, 0x7d, 0xa6, 0xf, 0xa, 0x13, 0x68, 0xc4, 0xdc, 0xbd, 0x47, 0xe, 0xa2, 0xff, 0xa3, 0x71, 0x70, 0x55, 0x9d, 0x1c, 0x60, 0x29, 0x4a}}
-	return a, nil
-}
-
-var _blockchainTransactionsScriptsGet_nextpiggyidCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x08\xc8\x4c\x4f\xaf\x74\x4a\xcc\xcb\x2e\x56\x48\x2b\xca\xcf\x55\x30\xa8\x08\xf0\x74\x77\x8f\x74\x74\x71\x09\x72\x0d\x0e\xe6\xe2\xe2\x2a\x28\x4d\x52\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\xd0\xb4\x52\x08\xf5\xcc\x2b\x31\x36\x52\xa8\xe6\xe2\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\x43\x32\x45\x2f\x2f\xb5\xa2\xa4\x00\xc4\xf5\x74\xe1\xaa\x05\x04\x00\x00\xff\xff\x06\x68\x59\xd0\x65\x00\x00\x00")
-
-func blockchainTransactionsScriptsGet_nextpiggyidCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainTransactionsScriptsGet_nextpiggyidCdc,
-		"blockchain/transactions/scripts/get_nextPiggyID.cdc",
-	)
-}
-
-func blockchainTransactionsScriptsGet_nextpiggyidCdc() (*asset, error) {
-	bytes, err := blockchainTransactionsScriptsGet_nextpiggyidCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/transactions/scripts/get_nextPiggyID.cdc", size: 101, mode: os.FileMode(0644), modTime: time.Unix(1677642032, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbe, 0x91, 0xd6, 0x5b, 0x1a, 0x34, 0xab, 0x79, 0x16, 0xff, 0x55, 0xfe, 0xe8, 0x9d, 0xa1, 0xec, 0x4a, 0xea, 0x37, 0x78, 0x73, 0xe7, 0x35, 0x45, 0x10, 0x59, 0xbe, 0xfe, 0xd4, 0xe1, 0xf8, 0xf4}}
-	return a, nil
-}
-
-var _blockchainTransactionsScriptsGet_totalsupplyCdc = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x08\xc8\x4c\x4f\xaf\x74\x4a\xcc\xcb\x2e\x56\x48\x2b\xca\xcf\x55\x30\xa8\x08\xf0\x74\x77\x8f\x74\x74\x71\x09\x72\x0d\x0e\xe6\xe2\xe2\x2a\x28\x4d\x52\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\xd0\xb4\x52\x08\xf5\xcc\x2b\x31\x33\x51\xa8\xe6\xe2\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\x43\x32\x45\xaf\x24\xbf\x24\x31\x27\xb8\xb4\xa0\x20\xa7\x92\xab\x16\x10\x00\x00\xff\xff\xed\x2d\x5d\xd2\x65\x00\x00\x00")
-
-func blockchainTransactionsScriptsGet_totalsupplyCdcBytes() ([]byte, error) {
-	return bindataRead(
-		_blockchainTransactionsScriptsGet_totalsupplyCdc,
-		"blockchain/transactions/scripts/get_totalSupply.cdc",
-	)
-}
-
-func blockchainTransactionsScriptsGet_totalsupplyCdc() (*asset, error) {
-	bytes, err := blockchainTransactionsScriptsGet_totalsupplyCdcBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "blockchain/transactions/scripts/get_totalSupply.cdc", size: 101, mode: os.FileMode(0644), modTime: time.Unix(1677642029, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaf, 0xa4, 0xf0, 0x1e, 0xbe, 0x24, 0x6c, 0x3e, 0x68, 0xd0, 0xc3, 0x57, 0x94, 0xe9, 0xf1, 0xa1, 0x4a, 0xb0, 0xc7, 0x60, 0x26, 0xa6, 0xf, 0xfc, 0xe6, 0xe2, 0xa1, 0x68, 0x17, 0x6b, 0xa5, 0xf4}}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// AssetString returns the asset contents as a string (instead of a []byte).
-func AssetString(name string) (string, error) {
-	data, err := Asset(name)
-	return string(data), err
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// MustAssetString is like AssetString but panics when Asset would return an
-// error. It simplifies safe initialization of global variables.
-func MustAssetString(name string) string {
-	return string(MustAsset(name))
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetDigest returns the digest of the file with the given name. It returns an
-// error if the asset could not be found or the digest could not be loaded.
-func AssetDigest(name string) ([sha256.Size]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s can't read by error: %v", name, err)
-		}
-		return a.digest, nil
-	}
-	return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s not found", name)
-}
-
-// Digests returns a map of all known files and their checksums.
-func Digests() (map[string][sha256.Size]byte, error) {
-	mp := make(map[string][sha256.Size]byte, len(_bindata))
-	for name := range _bindata {
-		a, err := _bindata[name]()
-		if err != nil {
-			return nil, err
-		}
-		mp[name] = a.digest
-	}
-	return mp, nil
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"blockchain/transactions/admin/create_piggy.cdc":      blockchainTransactionsAdminCreate_piggyCdc,
-	"blockchain/transactions/admin/mint_donation.cdc":     blockchainTransactionsAdminMint_donationCdc,
-	"blockchain/transactions/admin/transfer_admin.cdc":    blockchainTransactionsAdminTransfer_adminCdc,
-	"blockchain/contracts/FungibleToken.cdc":              blockchainContractsFungibletokenCdc,
-	"blockchain/contracts/MetadataViews.cdc":              blockchainContractsMetadataviewsCdc,
-	"blockchain/contracts/NonFungibleToken.cdc":           blockchainContractsNonfungibletokenCdc,
-	"blockchain/contracts/piggy.cdc":                      blockchainContractsPiggyCdc,
-	"blockchain/transactions/user/setup_account.cdc":      blockchainTransactionsUserSetup_accountCdc,
-	"blockchain/transactions/scripts/get_nextPiggyID.cdc": blockchainTransactionsScriptsGet_nextpiggyidCdc,
-	"blockchain/transactions/scripts/get_totalSupply.cdc": blockchainTransactionsScriptsGet_totalsupplyCdc,
-}
-
-// AssetDebug is true if the assets were built with the debug flag enabled.
-const AssetDebug = false
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//
-//	data/
-//	  foo.txt
-//	  img/
-//	    a.png
-//	    b.png
-//
-// then AssetDir("data") would return []string{"foo.txt", "img"},
-// AssetDir("data/img") would return []string{"a.png", "b.png"},
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		canonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(canonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"blockchain": {nil, map[string]*bintree{
-		"contracts": {nil, map[string]*bintree{
-			"FungibleToken.cdc": {blockchainContractsFungibletokenCdc, map[string]*bintree{}},
-			"MetadataViews.cdc": {blockchainContractsMetadataviewsCdc, map[string]*bintree{}},
-			"NonFungibleToken.cdc": {blockchainContractsNonfungibletokenCdc, map[string]*bintree{}},
-			"piggy.cdc": {blockchainContractsPiggyCdc, map[string]*bintree{}},
-		}},
-		"transactions": {nil, map[string]*bintree{
-			"admin": {nil, map[string]*bintree{
-				"create_piggy.cdc": {blockchainTransactionsAdminCreate_piggyCdc, map[string]*bintree{}},
-				"mint_donation.cdc": {blockchainTransactionsAdminMint_donationCdc, map[string]*bintree{}},
-				"transfer_admin.cdc": {blockchainTransactionsAdminTransfer_adminCdc, map[string]*bintree{}},
-			}},
-			"scripts": {nil, map[string]*bintree{
-				"get_nextPiggyID.cdc": {blockchainTransactionsScriptsGet_nextpiggyidCdc, map[string]*bintree{}},
-				"get_totalSupply.cdc": {blockchainTransactionsScriptsGet_totalsupplyCdc, map[string]*bintree{}},
-			}},
-			"user": {nil, map[string]*bintree{
-				"setup_account.cdc": {blockchainTransactionsUserSetup_accountCdc, map[string]*bintree{}},
-			}},
-		}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory.
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = os.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-}
-
-// RestoreAssets restores an asset under the given directory recursively.
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(canonicalName, "/")...)...)
-}
@@ -0,0 +1,195 @@
+package flow
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/manubidegain/piggy-api/blockchain"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+// contractFiles are the files of the contracts the templates import, by contract name.
+var contractFiles = map[string]string{
+	"FungibleToken":    "contracts/FungibleToken.cdc",
+	"NonFungibleToken": nonFungibleTokenFilename,
+	"MetadataViews":    metadataViewsFilename,
+	"PiggyBanks":       piggyFilename,
+}
+
+// checkEnv replaces the placeholders of the templates to check them, the imports are resolved by contract name.
+var checkEnv = Environment{
+	FungibleTokenAddress:    "01",
+	FlowTokenAddress:        "01",
+	NonFungibleTokenAddress: "01",
+	MetadataViewsAddress:    "01",
+	PiggyAddress:            "01",
+}
+
+var (
+	checkValues = append(stdlib.FlowBuiltInFunctions(stdlib.DefaultFlowBuiltinImpls()), stdlib.BuiltinFunctions...).ToSemaValueDeclarations()
+	checkTypes  = append(stdlib.FlowBuiltInTypes, stdlib.BuiltinTypes...).ToTypeDeclarations()
+)
+
+// CheckAssets parses and type-checks every .cdc file in assets against the contracts in assets,
+// returning the errors of all the broken files.
+func CheckAssets(assets fs.FS) error {
+	checker := newCadenceChecker(assets)
+	broken := []string{}
+	err := fs.WalkDir(assets, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(name) != ".cdc" {
+			return err
+		}
+		code, err := fs.ReadFile(assets, name)
+		if err != nil {
+			return err
+		}
+		if err := checker.check(replaceAddresses(string(code), checkEnv), common.StringLocation(name)); err != nil {
+			broken = append(broken, fmt.Sprintf("%s: %s", name, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(broken) > 0 {
+		return errors.New(strings.Join(broken, "\n"))
+	}
+	return nil
+}
+
+// CheckCode parses and type-checks code generated from a template against the contracts in blockchain.Assets.
+func CheckCode(code []byte) error {
+	return newCadenceChecker(blockchain.Assets).check(string(code), common.StringLocation("code"))
+}
+
+type cadenceChecker struct {
+	assets    fs.FS
+	contracts map[string]*sema.Elaboration
+}
+
+func newCadenceChecker(assets fs.FS) *cadenceChecker {
+	return &cadenceChecker{assets: assets, contracts: map[string]*sema.Elaboration{}}
+}
+
+func (c *cadenceChecker) check(code string, location common.Location) error {
+	_, err := c.elaborate(code, location)
+	return err
+}
+
+func (c *cadenceChecker) elaborate(code string, location common.Location) (*sema.Elaboration, error) {
+	program, err := parser2.ParseProgram(code, nil)
+	if err != nil {
+		return nil, describe(err)
+	}
+	interfaces := program.InterfaceDeclarations()
+	for _, composite := range program.CompositeDeclarations() {
+		interfaces = append(interfaces, composite.Members.Interfaces()...)
+	}
+	stripDefaultFunctions(interfaces)
+	checker, err := sema.NewChecker(program, location, nil,
+		sema.WithPredeclaredValues(checkValues),
+		sema.WithPredeclaredTypes(checkTypes),
+		sema.WithLocationHandler(resolveByName),
+		sema.WithImportHandler(func(_ *sema.Checker, imported common.Location, _ ast.Range) (sema.Import, error) {
+			elaboration, err := c.contract(imported)
+			if err != nil {
+				return nil, err
+			}
+			return sema.ElaborationImport{Elaboration: elaboration}, nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := checker.Check(); err != nil {
+		return nil, describe(err)
+	}
+	return checker.Elaboration, nil
+}
+
+// contract checks the contract imported from location once.
+func (c *cadenceChecker) contract(location common.Location) (*sema.Elaboration, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return nil, fmt.Errorf("cannot import %s", location)
+	}
+	name := addressLocation.Name
+	if elaboration, ok := c.contracts[name]; ok {
+		return elaboration, nil
+	}
+	file, ok := contractFiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown contract %s", name)
+	}
+	code, err := fs.ReadFile(c.assets, file)
+	if err != nil {
+		return nil, err
+	}
+	elaboration, err := c.elaborate(replaceAddresses(string(code), checkEnv), location)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	c.contracts[name] = elaboration
+	return elaboration, nil
+}
+
+// stripDefaultFunctions keeps only the signatures and conditions of the default functions of the interfaces,
+// as getViews and resolveView of FungibleToken.Balance. The chains run them, but the checker of
+// the cadence version the api builds with predates them and rejects any interface function with a body.
+func stripDefaultFunctions(interfaces []*ast.InterfaceDeclaration) {
+	for _, declaration := range interfaces {
+		for _, function := range declaration.Members.Functions() {
+			block := function.FunctionBlock
+			if block == nil || block.Block == nil || len(block.Block.Statements) == 0 {
+				continue
+			}
+			if (block.PreConditions == nil || len(*block.PreConditions) == 0) &&
+				(block.PostConditions == nil || len(*block.PostConditions) == 0) {
+				function.FunctionBlock = nil
+				continue
+			}
+			block.Block.Statements = nil
+		}
+		stripDefaultFunctions(declaration.Members.Interfaces())
+	}
+}
+
+// resolveByName resolves `import A, B from 0x01` to a location per contract, as the Flow runtime does.
+func resolveByName(identifiers []ast.Identifier, location common.Location) ([]sema.ResolvedLocation, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return []sema.ResolvedLocation{{Location: location, Identifiers: identifiers}}, nil
+	}
+	resolved := []sema.ResolvedLocation{}
+	for _, identifier := range identifiers {
+		resolved = append(resolved, sema.ResolvedLocation{
+			Location:    common.AddressLocation{Address: addressLocation.Address, Name: identifier.Identifier},
+			Identifiers: []ast.Identifier{identifier},
+		})
+	}
+	return resolved, nil
+}
+
+// describe lists the errors the parser or the checker found instead of their summary.
+func describe(err error) error {
+	var parent interface{ ChildErrors() []error }
+	if !errors.As(err, &parent) || len(parent.ChildErrors()) == 0 {
+		return err
+	}
+	messages := []string{}
+	for _, child := range parent.ChildErrors() {
+		message := child.Error()
+		if positioned, ok := child.(ast.HasPosition); ok {
+			message = fmt.Sprintf("%d:%d: %s", positioned.StartPosition().Line, positioned.StartPosition().Column, message)
+		}
+		messages = append(messages, message)
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
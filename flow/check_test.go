@@ -0,0 +1,79 @@
+package flow
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/manubidegain/piggy-api/blockchain"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAssets(t *testing.T) {
+	assert.NoError(t, CheckAssets(blockchain.Assets))
+}
+
+func TestCheckAssetsBrokenTemplate(t *testing.T) {
+	assets := fstest.MapFS{}
+	for _, file := range contractFiles {
+		code, err := fs.ReadFile(blockchain.Assets, file)
+		require.NoError(t, err)
+		assets[file] = &fstest.MapFile{Data: code}
+	}
+	assets["transactions/admin/unparsable.cdc"] = &fstest.MapFile{Data: []byte(`
+import PiggyBanks from 0xPIGGYADDRESS
+transaction {
+    prepare(acct: AuthAccount) {
+        let admin = acct.load acct.borrow<&PiggyBanks.Admin>(from: /storage/PiggyBanksAdmin)
+    }
+}`)}
+	assets["transactions/scripts/unknown_field.cdc"] = &fstest.MapFile{Data: []byte(`
+import PiggyBanks from 0xPIGGYADDRESS
+pub fun main(): UInt64 {
+    return PiggyBanks.supply
+}`)}
+	assets["transactions/scripts/unknown_contract.cdc"] = &fstest.MapFile{Data: []byte(`
+import PiggyBankAdminReceiver from 0xPIGGYADDRESS
+pub fun main() {}`)}
+
+	err := CheckAssets(assets)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transactions/admin/unparsable.cdc")
+	assert.Contains(t, err.Error(), "transactions/scripts/unknown_field.cdc")
+	assert.Contains(t, err.Error(), "transactions/scripts/unknown_contract.cdc")
+}
+
+func TestGeneratedTemplates(t *testing.T) {
+	config, err := configuration.LoadFlowConfig("../flow.json")
+	require.NoError(t, err)
+	env := NewEnv("test", config)
+	templates := map[string]func(Environment) []byte{
+		"setup_account":           GenerateSetupAccount,
+		"transfer_donation":       GenerateTransferDonation,
+		"create_piggy":            GenerateCreatePiggy,
+		"mint_donation":           GenerateMintDonation,
+		"break_piggy":             GenerateBreakPiggy,
+		"transfer_admin":          GenerateTransferAdmin,
+		"get_nextPiggyID":         GenerateGetNextPiggyID,
+		"get_totalSupply":         GenerateGetTotalSupply,
+		"has_donation_collection": GenerateHasDonationCollection,
+		"get_all_piggies":         GenerateGetAllPiggies,
+		"get_piggy_metadata":      GenerateGetPiggyMetadata,
+		"get_piggy_num_donations": GenerateGetPiggyNumDonations,
+		"get_collection_ids":      GenerateGetCollectionIDs,
+		"get_donation":            GenerateGetDonation,
+		"get_donation_display":    GenerateGetDonationDisplay,
+		"PiggyBanks":              PiggyCode,
+	}
+	for name, generate := range templates {
+		t.Run(name, func(t *testing.T) {
+			assert.NoError(t, CheckCode(generate(env)))
+		})
+	}
+}
+
+func TestMissingAsset(t *testing.T) {
+	assert.Panics(t, func() { MustAssetString("transactions/admin/missing.cdc") })
+}
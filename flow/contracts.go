@@ -2,11 +2,10 @@ package flow
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
-	_ "github.com/kevinburke/go-bindata"
-
-	_ "github.com/kevinburke/go-bindata"
+	"github.com/manubidegain/piggy-api/blockchain"
 )
 
 /// This package contains utility functions to get contract code for the contracts in this repo
@@ -20,10 +19,9 @@ import (
 ///
 
 const (
-	nonFungibleTokenFilename   = "blockchain/contracts/NonFungibleToken.cdc"
-	metadataViewsFilename      = "blockchain/contracts/MetadataViews.cdc"
-	piggyFilename              = "blockchain/contracts/piggy.cdc"
-	flowServiceAccountFilename = "../blockchain-api/FlowServiceAccount.cdc"
+	nonFungibleTokenFilename = "contracts/NonFungibleToken.cdc"
+	metadataViewsFilename    = "contracts/MetadataViews.cdc"
+	piggyFilename            = "contracts/piggy.cdc"
 	// Test contracts
 
 	// Each contract has placeholder addresses that need to be replaced
@@ -33,6 +31,15 @@ const (
 	placeholderTicketAddress           = "0xPIGGYADDRESS"
 )
 
+// MustAssetString returns the Cadence file at name in blockchain.Assets, panicking when it is missing.
+func MustAssetString(name string) string {
+	code, err := blockchain.Assets.ReadFile(name)
+	if err != nil {
+		panic(fmt.Sprintf("cadence asset %s: %s", name, err))
+	}
+	return string(code)
+}
+
 // Adds a `0x` prefix to the provided address string
 func withHexPrefix(address string) string {
 	if address == "" {
@@ -80,32 +87,32 @@ func Piggy(nonFungibleTokenAddress, metadataViewsAddress string) []byte {
 	return []byte(code)
 }
 
-// FlowServiceAccount returns the FlowServiceAccount contract.
-//
-// The returned contract will import the FungibleToken, FlowToken, FlowFees, and FlowStorageFees
-// contracts from the specified addresses.
-func FlowServiceAccount(nonFungibleTokenAddress, metadataViewsAddress, ticketAddress string) []byte {
-	code := MustAssetString(flowServiceAccountFilename)
-
-	code = strings.ReplaceAll(
-		code,
-		placeholderNonFungibleTokenAddress,
-		withHexPrefix(nonFungibleTokenAddress),
-	)
-
-	code = strings.ReplaceAll(
-		code,
-		placeholderMetadataViewsAddress,
-		withHexPrefix(metadataViewsAddress),
-	)
-
-	code = strings.ReplaceAll(
-		code,
-		placeholderTicketAddress,
-		withHexPrefix(ticketAddress),
-	)
+// PiggyCode returns the PiggyBanks contract as deployed on the network of env.
+func PiggyCode(env Environment) []byte {
+	code := replaceAddresses(MustAssetString(piggyFilename), env)
+	return []byte(FixImports(code, env))
+}
 
-	return []byte(code)
+var importPattern = regexp.MustCompile(`(?m)^(\s*import\s+(\w+)\s+from\s+)0x[0-9a-fA-F]+`)
+
+// FixImports points the imports of the contracts in env to their address on its network,
+// since the contract files import them from the addresses they were written against.
+func FixImports(code string, env Environment) string {
+	addresses := map[string]string{
+		"FungibleToken":    env.FungibleTokenAddress,
+		"FlowToken":        env.FlowTokenAddress,
+		"NonFungibleToken": env.NonFungibleTokenAddress,
+		"MetadataViews":    env.MetadataViewsAddress,
+		"PiggyBanks":       env.PiggyAddress,
+	}
+	return importPattern.ReplaceAllStringFunc(code, func(declaration string) string {
+		match := importPattern.FindStringSubmatch(declaration)
+		address, ok := addresses[match[2]]
+		if !ok || address == "" {
+			return declaration
+		}
+		return match[1] + withHexPrefix(address)
+	})
 }
 
 func replaceAddresses(code string, env Environment) string {
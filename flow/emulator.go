@@ -0,0 +1,323 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/manubidegain/piggy-api/utils"
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	sdkconvert "github.com/onflow/flow-emulator/convert/sdk"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+	"github.com/onflow/flow-go/fvm"
+	flowgo "github.com/onflow/flow-go/model/flow"
+)
+
+var errNotSupported = errors.New("not supported by the embedded emulator")
+
+// EmulatorClient serves an emulator blockchain running in the process through access.Client.
+// Every transaction sent is executed and committed in its own block, so it is sealed when SendTransaction returns.
+type EmulatorClient struct {
+	mu         sync.Mutex
+	blockchain *emulator.Blockchain
+}
+
+func NewEmulatorClient(blockchain *emulator.Blockchain) *EmulatorClient {
+	return &EmulatorClient{blockchain: blockchain}
+}
+
+func (c *EmulatorClient) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (c *EmulatorClient) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
+	block, err := c.blockchain.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	return blockHeader(block), nil
+}
+
+func (c *EmulatorClient) GetBlockHeaderByID(ctx context.Context, blockID flow.Identifier) (*flow.BlockHeader, error) {
+	block, err := c.blockchain.GetBlockByID(blockID)
+	if err != nil {
+		return nil, err
+	}
+	return blockHeader(block), nil
+}
+
+func (c *EmulatorClient) GetBlockHeaderByHeight(ctx context.Context, height uint64) (*flow.BlockHeader, error) {
+	block, err := c.blockchain.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return blockHeader(block), nil
+}
+
+func (c *EmulatorClient) GetLatestBlock(ctx context.Context, isSealed bool) (*flow.Block, error) {
+	block, err := c.blockchain.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	return fullBlock(block), nil
+}
+
+func (c *EmulatorClient) GetBlockByID(ctx context.Context, blockID flow.Identifier) (*flow.Block, error) {
+	block, err := c.blockchain.GetBlockByID(blockID)
+	if err != nil {
+		return nil, err
+	}
+	return fullBlock(block), nil
+}
+
+func (c *EmulatorClient) GetBlockByHeight(ctx context.Context, height uint64) (*flow.Block, error) {
+	block, err := c.blockchain.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return fullBlock(block), nil
+}
+
+func (c *EmulatorClient) GetCollection(ctx context.Context, colID flow.Identifier) (*flow.Collection, error) {
+	return c.blockchain.GetCollection(colID)
+}
+
+// SendTransaction adds tx to the pending block and commits it. A transaction that fails
+// is committed too, its error is in the transaction result as on the network.
+func (c *EmulatorClient) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.blockchain.AddTransaction(tx); err != nil {
+		return err
+	}
+	_, _, err := c.blockchain.ExecuteAndCommitBlock()
+	return err
+}
+
+func (c *EmulatorClient) GetTransaction(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+	return c.blockchain.GetTransaction(txID)
+}
+
+func (c *EmulatorClient) GetTransactionResult(ctx context.Context, txID flow.Identifier) (*flow.TransactionResult, error) {
+	return c.blockchain.GetTransactionResult(txID)
+}
+
+func (c *EmulatorClient) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
+	return c.blockchain.GetAccount(address)
+}
+
+func (c *EmulatorClient) GetAccountAtLatestBlock(ctx context.Context, address flow.Address) (*flow.Account, error) {
+	return c.blockchain.GetAccount(address)
+}
+
+func (c *EmulatorClient) GetAccountAtBlockHeight(ctx context.Context, address flow.Address, blockHeight uint64) (*flow.Account, error) {
+	return c.blockchain.GetAccountAtBlock(address, blockHeight)
+}
+
+func (c *EmulatorClient) ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	block, err := c.blockchain.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	return c.ExecuteScriptAtBlockHeight(ctx, block.Header.Height, script, arguments)
+}
+
+func (c *EmulatorClient) ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifier, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	block, err := c.blockchain.GetBlockByID(blockID)
+	if err != nil {
+		return nil, err
+	}
+	return c.ExecuteScriptAtBlockHeight(ctx, block.Header.Height, script, arguments)
+}
+
+func (c *EmulatorClient) ExecuteScriptAtBlockHeight(ctx context.Context, height uint64, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	encoded := make([][]byte, len(arguments))
+	for i, argument := range arguments {
+		var err error
+		if encoded[i], err = jsoncdc.Encode(argument); err != nil {
+			return nil, err
+		}
+	}
+	result, err := c.blockchain.ExecuteScriptAtBlock(script, encoded, height)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Value, nil
+}
+
+func (c *EmulatorClient) GetEventsForHeightRange(ctx context.Context, eventType string, startHeight uint64, endHeight uint64) ([]flow.BlockEvents, error) {
+	latest, err := c.blockchain.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if endHeight > latest.Header.Height {
+		endHeight = latest.Header.Height
+	}
+	blocks := []flow.BlockEvents{}
+	for height := startHeight; height <= endHeight; height++ {
+		block, err := c.blockchain.GetBlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		events, err := c.blockEvents(block, eventType)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, events)
+	}
+	return blocks, nil
+}
+
+func (c *EmulatorClient) GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier) ([]flow.BlockEvents, error) {
+	blocks := []flow.BlockEvents{}
+	for _, blockID := range blockIDs {
+		block, err := c.blockchain.GetBlockByID(blockID)
+		if err != nil {
+			return nil, err
+		}
+		events, err := c.blockEvents(block, eventType)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, events)
+	}
+	return blocks, nil
+}
+
+func (c *EmulatorClient) GetLatestProtocolStateSnapshot(ctx context.Context) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+func (c *EmulatorClient) GetExecutionResultForBlockID(ctx context.Context, blockID flow.Identifier) (*flow.ExecutionResult, error) {
+	return nil, errNotSupported
+}
+
+// Close keeps the emulator running, every connection of the process shares it.
+func (c *EmulatorClient) Close() error {
+	return nil
+}
+
+func (c *EmulatorClient) blockEvents(block *flowgo.Block, eventType string) (flow.BlockEvents, error) {
+	events, err := c.blockchain.GetEventsByHeight(block.Header.Height, eventType)
+	if err != nil {
+		return flow.BlockEvents{}, err
+	}
+	return flow.BlockEvents{
+		BlockID:        sdkconvert.FlowIdentifierToSDK(block.ID()),
+		Height:         block.Header.Height,
+		BlockTimestamp: block.Header.Timestamp,
+		Events:         events,
+	}, nil
+}
+
+func blockHeader(block *flowgo.Block) *flow.BlockHeader {
+	return &flow.BlockHeader{
+		ID:        sdkconvert.FlowIdentifierToSDK(block.ID()),
+		ParentID:  sdkconvert.FlowIdentifierToSDK(block.Header.ParentID),
+		Height:    block.Header.Height,
+		Timestamp: block.Header.Timestamp,
+	}
+}
+
+func fullBlock(block *flowgo.Block) *flow.Block {
+	return &flow.Block{
+		BlockHeader:  *blockHeader(block),
+		BlockPayload: sdkconvert.FlowPayloadToSDK(block.Payload),
+	}
+}
+
+var embeddedEnv struct {
+	sync.Mutex
+	env *Environment
+}
+
+// StartEmbeddedEmulator starts an emulator in the process, deploys NonFungibleToken, MetadataViews and
+// PiggyBanks on its service account, which holds the PiggyBanks admin as on testnet, and makes the dev
+// profile use it: ConnectToFlow returns its client, NewEnv its addresses and the custodial keys are kept in memory.
+func StartEmbeddedEmulator(ctx context.Context) (*EmulatorClient, error) {
+	blockchain := newBlockchain()
+	client := NewEmulatorClient(blockchain)
+	serviceKey := blockchain.ServiceKey()
+	service := serviceKey.Address
+
+	env := emulatorEnv(blockchain)
+
+	signer, err := utils.NewInMemorySigner(serviceKey.PrivateKey, serviceKey.HashAlgo)
+	if err != nil {
+		return nil, err
+	}
+	for _, contract := range []templates.Contract{
+		{Name: "NonFungibleToken", Source: string(NonFungibleToken())},
+		{Name: "MetadataViews", Source: FixImports(string(MetadataViews()), env)},
+		{Name: "PiggyBanks", Source: string(PiggyCode(env))},
+	} {
+		if err := deployContract(ctx, client, service, signer, contract); err != nil {
+			return nil, fmt.Errorf("cannot deploy %s: %w", contract.Name, err)
+		}
+	}
+
+	if err := utils.UseEmbeddedEmulator(ctx, client, service, signer, utils.NewMemoryAccountKeys()); err != nil {
+		return nil, err
+	}
+	embeddedEnv.Lock()
+	embeddedEnv.env = &env
+	embeddedEnv.Unlock()
+	return client, nil
+}
+
+// emulatorEnv returns the addresses of the token contracts of the emulator chain,
+// and the service account for the contracts of the app.
+func emulatorEnv(blockchain *emulator.Blockchain) Environment {
+	chain := blockchain.GetChain()
+	service := blockchain.ServiceKey().Address.Hex()
+	return Environment{
+		Network:                 "emulator",
+		FungibleTokenAddress:    fvm.FungibleTokenAddress(chain).Hex(),
+		FlowTokenAddress:        fvm.FlowTokenAddress(chain).Hex(),
+		NonFungibleTokenAddress: service,
+		MetadataViewsAddress:    service,
+		PiggyAddress:            service,
+		ServiceAccountAddress:   service,
+	}
+}
+
+func deployContract(ctx context.Context, client *EmulatorClient, address flow.Address, signer utils.Signer, contract templates.Contract) error {
+	account, err := client.GetAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+	key := account.Keys[0]
+	block, err := client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return err
+	}
+	tx := templates.AddAccountContract(address, contract).
+		SetReferenceBlockID(block.ID).
+		SetGasLimit(9999).
+		SetProposalKey(address, key.Index, key.SequenceNumber).
+		SetPayer(address)
+	if err := tx.SignEnvelope(address, key.Index, signer); err != nil {
+		return err
+	}
+	if err := client.SendTransaction(ctx, *tx); err != nil {
+		return err
+	}
+	_, err = utils.WaitForSeal(ctx, client, tx.ID())
+	return err
+}
+
+func embeddedEnvironment() (Environment, bool) {
+	embeddedEnv.Lock()
+	defer embeddedEnv.Unlock()
+	if embeddedEnv.env == nil {
+		return Environment{}, false
+	}
+	return *embeddedEnv.env, true
+}
@@ -6,8 +6,10 @@ import (
 	"io/ioutil"
 	"log"
 	"math/rand"
+	"strings"
 	"testing"
 
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
 	"github.com/manubidegain/piggy-api/utils"
 	"github.com/onflow/cadence/runtime/sema"
 	emulator "github.com/onflow/flow-emulator"
@@ -42,35 +44,6 @@ import (
 *
 ************************************************/
 
-const (
-	mainnetFTAddress                = "f233dcee88fe0abe"
-	mainnetFlowTokenAddress         = "1654653399040a61"
-	mainnetFlowFeesAddress          = "f919ee77447b7497"
-	mainnetNonFungibleTokenAddress  = "1d7e57aa55817448"
-	mainnetMetadataViewsAddress     = "1d7e57aa55817448"
-	mainnetNFTStoreFrontAddress     = "e5a8b7f23e8b5483"
-	mainnetPiggyAddress             = "09e8665388e90671"
-	mainnetAnotherAccountAddress    = "e5a8b7f23e8b5486"
-	testnetFTAddress                = "9a0766d93b6608b7"
-	testnetFlowTokenAddress         = "7e60df042a9c0868"
-	testnetFlowFeesAddress          = "912d5440f7e3769e"
-	testnetNonFungibleTokenAddress  = "631e88ae7f1d7c20"
-	testnetMetadataViewsAddress     = "631e88ae7f1d7c20"
-	testnetNFTStoreFrontAddress     = "e5a8b7f23e8b5483"
-	testnetPiggyAddress             = "36e55122ece3464c"
-	testnetAnotherAccountAddress    = "e5a8b7f23e8b5486"
-	emulatorFTAddress               = "f233dcee88fe0abe"
-	emulatorFlowTokenAddress        = "1654653399040a61"
-	emulatorFlowFeesAddress         = "f919ee77447b7497"
-	emulatorNonFungibleTokenAddress = "1d7e57aa55817448"
-	emulatorMetadataViewsAddress    = "1d7e57aa55817448"
-	emulatorNFTStoreFrontAddress    = "e5a8b7f23e8b5483"
-	emulatorPiggyAddress            = "36e55122ece3464c"
-	emulatorAnotherAccountAddress   = "e5a8b7f23e8b5486"
-	getExecutionEffortWeighs        = "FlowServiceAccount/scripts/get_execution_effort_weights.cdc"
-	setExecutionEffortWeighs        = "FlowServiceAccount/set_execution_effort_weights.cdc"
-)
-
 type Environment struct {
 	Network                    string
 	NonFungibleTokenAddress    string
@@ -92,39 +65,29 @@ type Environment struct {
 	ServiceAccountAddress      string
 }
 
-func NewEnv(profile string) Environment {
-	if profile == "prod" {
-		return Environment{
-			FungibleTokenAddress:    mainnetFTAddress,
-			FlowTokenAddress:        mainnetFlowTokenAddress,
-			NonFungibleTokenAddress: mainnetNonFungibleTokenAddress,
-			PiggyAddress:            mainnetPiggyAddress,
-			MetadataViewsAddress:    mainnetMetadataViewsAddress,
-			NFTStoreFrontAddress:    mainnetNFTStoreFrontAddress,
-			AnotherAccountAddress:   mainnetAnotherAccountAddress,
-		}
-
-	} else if profile == "test" {
-		return Environment{
-			FungibleTokenAddress:    testnetFTAddress,
-			FlowTokenAddress:        testnetFlowTokenAddress,
-			NonFungibleTokenAddress: testnetNonFungibleTokenAddress,
-			PiggyAddress:            testnetPiggyAddress,
-			MetadataViewsAddress:    testnetMetadataViewsAddress,
-			NFTStoreFrontAddress:    testnetNFTStoreFrontAddress,
-			AnotherAccountAddress:   testnetAnotherAccountAddress,
-		}
-	} else {
-		return Environment{
-			FungibleTokenAddress:    emulatorFTAddress,
-			FlowTokenAddress:        emulatorFlowTokenAddress,
-			NonFungibleTokenAddress: emulatorNonFungibleTokenAddress,
-			PiggyAddress:            emulatorPiggyAddress,
-			MetadataViewsAddress:    emulatorMetadataViewsAddress,
-			NFTStoreFrontAddress:    emulatorNFTStoreFrontAddress,
-			AnotherAccountAddress:   emulatorAnotherAccountAddress,
+// NewEnv returns the addresses of the contracts on the network of profile, as configured in flow.json.
+// When an embedded emulator runs, the dev profile gets the addresses of its contracts.
+func NewEnv(profile string, config *configuration.FlowConfig) Environment {
+	if profile != utils.Production && profile != utils.Test {
+		if env, ok := embeddedEnvironment(); ok {
+			return env
 		}
 	}
+	// flow.json is validated on startup, every required contract has an address
+	address := func(contract string) string {
+		address, _ := config.ContractAddress(profile, contract)
+		return address
+	}
+	service, _ := config.ServiceAccount(profile)
+	return Environment{
+		Network:                 configuration.NetworkName(profile),
+		FungibleTokenAddress:    address("FungibleToken"),
+		FlowTokenAddress:        address("FlowToken"),
+		NonFungibleTokenAddress: address("NonFungibleToken"),
+		MetadataViewsAddress:    address("MetadataViews"),
+		PiggyAddress:            address("PiggyBanks"),
+		ServiceAccountAddress:   strings.TrimPrefix(service.Address, "0x"),
+	}
 }
 
 // Sets up testing and emulator objects and initialize the emulator default addresses
@@ -140,15 +103,7 @@ func newTestSetup(t *testing.T) (*emulator.Blockchain, *test.AccountKeys, Enviro
 	accountKeys := test.AccountKeyGenerator()
 
 	// Setup the env variable that stores import addresses for various contracts
-	env := Environment{
-		FungibleTokenAddress:    emulatorFTAddress,
-		FlowTokenAddress:        emulatorFlowTokenAddress,
-		NonFungibleTokenAddress: emulatorNonFungibleTokenAddress,
-		PiggyAddress:            emulatorPiggyAddress,
-		MetadataViewsAddress:    emulatorMetadataViewsAddress,
-		NFTStoreFrontAddress:    emulatorNFTStoreFrontAddress,
-		AnotherAccountAddress:   emulatorAnotherAccountAddress,
-	}
+	env := emulatorEnv(b)
 
 	return b, accountKeys, env
 }
@@ -160,7 +115,15 @@ func newTestNetSetup(t *testing.T) (access.Client, *test.AccountKeys, Environmen
 	// Create a new emulator instance
 	//b := newBlockchain()
 
-	flowClient, err := grpc.NewClient(grpc.TestnetHost)
+	config, err := configuration.LoadFlowConfig("../flow.json")
+	if err != nil {
+		panic(err)
+	}
+	host, err := config.Host(utils.Test)
+	if err != nil {
+		panic(err)
+	}
+	flowClient, err := grpc.NewClient(host)
 
 	if err != nil {
 		panic(err)
@@ -171,15 +134,7 @@ func newTestNetSetup(t *testing.T) (access.Client, *test.AccountKeys, Environmen
 	accountKeys := test.AccountKeyGenerator()
 
 	// Setup the env variable that stores import addresses for various contracts
-	env := Environment{
-		FungibleTokenAddress:    emulatorFTAddress,
-		FlowTokenAddress:        emulatorFlowTokenAddress,
-		NonFungibleTokenAddress: emulatorNonFungibleTokenAddress,
-		PiggyAddress:            emulatorPiggyAddress,
-		MetadataViewsAddress:    emulatorMetadataViewsAddress,
-		NFTStoreFrontAddress:    emulatorNFTStoreFrontAddress,
-		AnotherAccountAddress:   emulatorAnotherAccountAddress,
-	}
+	env := NewEnv(utils.Test, config)
 
 	return flowClient, accountKeys, env
 }
@@ -471,8 +426,9 @@ func assertEqual(t *testing.T, expected, actual interface{}) bool {
 func mintTokensForAccount(t *testing.T, b *emulator.Blockchain, recipient flow.Address, amount string) {
 
 	// Create a new mint FLOW transaction template authorized by the service account
+	env := emulatorEnv(b)
 	tx := createTxWithTemplateAndAuthorizer(b,
-		ft_templates.GenerateMintTokensScript(flow.HexToAddress(emulatorFTAddress), flow.HexToAddress(emulatorFlowTokenAddress), "FlowToken"),
+		ft_templates.GenerateMintTokensScript(flow.HexToAddress(env.FungibleTokenAddress), flow.HexToAddress(env.FlowTokenAddress), "FlowToken"),
 		b.ServiceKey().Address)
 
 	// Add the recipient and amount as arguments
@@ -550,8 +506,11 @@ transaction(amount: UFix64, recipient: Address) {
 }
 `
 
-func FundAccount(flowClient access.Client, e Environment, recip flow.Address, amount float64, authorizer flow.Address, accountKey *flow.AccountKey, log *log.Logger) *flow.Transaction {
-	referenceBlockID := utils.GetReferenceBlockId(flowClient, log)
+func FundAccount(flowClient access.Client, e Environment, recip flow.Address, amount float64, authorizer flow.Address, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
+	referenceBlockID, err := utils.GetReferenceBlockId(flowClient, log)
+	if err != nil {
+		return nil, err
+	}
 
 	// Take this from ENV
 	fungibleTokenAddress := flow.HexToAddress("0x" + e.FungibleTokenAddress)
@@ -571,11 +530,14 @@ func FundAccount(flowClient access.Client, e Environment, recip flow.Address, am
 			SetReferenceBlockID(referenceBlockID).
 			SetPayer(authorizer)
 
-	return fundAccountTx
+	return fundAccountTx, nil
 }
 
-func SetupAccount(client access.Client, e Environment, address flow.Address, accountKey *flow.AccountKey, log *log.Logger) *flow.Transaction {
-	referenceBlockID := utils.GetReferenceBlockId(client, log)
+func SetupAccount(client access.Client, e Environment, address flow.Address, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
+	if err != nil {
+		return nil, err
+	}
 	tx := flow.NewTransaction().
 		SetScript(GenerateSetupAccount(e)).
 		SetGasLimit(9999).
@@ -584,7 +546,45 @@ func SetupAccount(client access.Client, e Environment, address flow.Address, acc
 		SetPayer(address).
 		AddAuthorizer(address)
 
-	return tx
+	return tx, nil
+}
+
+func TransferDonation(client access.Client, e Environment, address flow.Address, nftID uint64, recipientAddress flow.Address, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(GenerateTransferDonation(e)).
+		SetGasLimit(9999).
+		SetProposalKey(address, accountKey.Index, accountKey.SequenceNumber).
+		SetReferenceBlockID(referenceBlockID).
+		SetPayer(address).
+		AddAuthorizer(address)
+
+	err = tx.AddArgument(cadence.NewUInt64(nftID))
+	if err != nil {
+		return nil, err
+	}
+	err = tx.AddArgument(CadenceAddress(recipientAddress))
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// HasDonationCollection reports whether address exposes a PiggyBanks collection at /public/DonationCollection.
+func HasDonationCollection(ctx context.Context, client access.Client, e Environment, address flow.Address) (bool, error) {
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, GenerateHasDonationCollection(e), []cadence.Value{CadenceAddress(address)})
+	if err != nil {
+		return false, err
+	}
+	hasCollection, ok := result.(cadence.Bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected script result %s", result)
+	}
+	return bool(hasCollection), nil
 }
 
 func createRandomPrivateKey() crypto.PrivateKey {
@@ -606,7 +606,10 @@ func createRandomPrivateKey() crypto.PrivateKey {
 
 func CreatePiggy(client access.Client, e Environment, address flow.Address, metadata map[string]string, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
 
-	referenceBlockID := utils.GetReferenceBlockId(client, log)
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
+	if err != nil {
+		return nil, err
+	}
 
 	tx := flow.NewTransaction().
 		SetScript(GenerateCreatePiggy(e)).
@@ -616,7 +619,7 @@ func CreatePiggy(client access.Client, e Environment, address flow.Address, meta
 		SetPayer(address).
 		AddAuthorizer(address)
 
-	err := tx.AddArgument(CadenceMapStringString(metadata))
+	err = tx.AddArgument(CadenceMapStringString(metadata))
 	if err != nil {
 		return nil, err
 	}
@@ -645,7 +648,10 @@ func mintDonation(b *emulator.Blockchain, e Environment, address flow.Address, p
 }
 
 func MintDonation(client access.Client, e Environment, address flow.Address, piggyID int, donationComment string, recipientAddress flow.Address, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
-	referenceBlockID := utils.GetReferenceBlockId(client, log)
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
+	if err != nil {
+		return nil, err
+	}
 
 	tx := flow.NewTransaction().
 		SetScript(GenerateMintDonation(e)).
@@ -655,7 +661,7 @@ func MintDonation(client access.Client, e Environment, address flow.Address, pig
 		SetPayer(address).
 		AddAuthorizer(address)
 
-	err := tx.AddArgument(cadence.NewUInt32(uint32(piggyID)))
+	err = tx.AddArgument(cadence.NewUInt32(uint32(piggyID)))
 	if err != nil {
 		return nil, err
 	}
@@ -669,6 +675,35 @@ func MintDonation(client access.Client, e Environment, address flow.Address, pig
 	}
 	return tx, nil
 }
+func BreakPiggy(client access.Client, e Environment, address flow.Address, piggyID int, collectedAmount uint64, breakerRoyalty uint64, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(GenerateBreakPiggy(e)).
+		SetGasLimit(9999).
+		SetProposalKey(address, accountKey.Index, accountKey.SequenceNumber).
+		SetReferenceBlockID(referenceBlockID).
+		SetPayer(address).
+		AddAuthorizer(address)
+
+	err = tx.AddArgument(cadence.NewUInt32(uint32(piggyID)))
+	if err != nil {
+		return nil, err
+	}
+	err = tx.AddArgument(cadence.NewUInt64(collectedAmount))
+	if err != nil {
+		return nil, err
+	}
+	err = tx.AddArgument(cadence.NewUInt64(breakerRoyalty))
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
 func setupAccount(b *emulator.Blockchain, e Environment, address flow.Address) *flow.Transaction {
 
 	tx := createTxWithTemplateAndAuthorizer(b,
@@ -678,15 +713,20 @@ func setupAccount(b *emulator.Blockchain, e Environment, address flow.Address) *
 	return tx
 }
 
-func transferAdmin(b *emulator.Blockchain, e Environment, address flow.Address) (*flow.Transaction, error) {
+func transferAdmin(b *emulator.Blockchain, e Environment, address flow.Address, newAdmin flow.Address) (*flow.Transaction, error) {
 	tx := createTxWithTemplateAndAuthorizer(b,
 		GenerateTransferAdmin(e),
-		address)
+		address).
+		AddAuthorizer(newAdmin)
 	return tx, nil
 }
 
-func TranferAdmin(client access.Client, e Environment, address flow.Address, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
-	referenceBlockID := utils.GetReferenceBlockId(client, log)
+// TranferAdmin moves the PiggyBanks Admin from address to newAdmin, the transaction has to be signed by both accounts.
+func TranferAdmin(client access.Client, e Environment, address flow.Address, newAdmin flow.Address, accountKey *flow.AccountKey, log *log.Logger) (*flow.Transaction, error) {
+	referenceBlockID, err := utils.GetReferenceBlockId(client, log)
+	if err != nil {
+		return nil, err
+	}
 
 	tx := flow.NewTransaction().
 		SetScript(GenerateTransferAdmin(e)).
@@ -694,7 +734,8 @@ func TranferAdmin(client access.Client, e Environment, address flow.Address, acc
 		SetProposalKey(address, accountKey.Index, accountKey.SequenceNumber).
 		SetReferenceBlockID(referenceBlockID).
 		SetPayer(address).
-		AddAuthorizer(address)
+		AddAuthorizer(address).
+		AddAuthorizer(newAdmin)
 
 	return tx, nil
 }
@@ -0,0 +1,269 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// PiggyData is a PiggyBanks.Piggy struct read from the contract.
+type PiggyData struct {
+	ID              uint32            `json:"piggy_id"`
+	Metadata        map[string]string `json:"metadata"`
+	Broken          bool              `json:"broken"`
+	CollectedAmount uint64            `json:"collected_amount"`
+	BreakerRoyalty  uint64            `json:"breaker_royalty"`
+}
+
+// DonationData is the data of a donation NFT read from an account collection.
+type DonationData struct {
+	ID              uint64 `json:"nft_id"`
+	PiggyID         uint32 `json:"piggy_id"`
+	SerialNumber    uint32 `json:"serial_number"`
+	DonationComment string `json:"donation_comment"`
+}
+
+// DisplayData is a MetadataViews.Display view, with the thumbnail file resolved to a url.
+type DisplayData struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Thumbnail   string `json:"thumbnail"`
+}
+
+// GetAllPiggies returns every piggy created in the contract.
+func GetAllPiggies(ctx context.Context, client access.Client, e Environment) ([]PiggyData, error) {
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, GenerateGetAllPiggies(e), nil)
+	if err != nil {
+		return nil, err
+	}
+	array, ok := result.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("unexpected script result %s", result)
+	}
+	piggies := []PiggyData{}
+	for _, value := range array.Values {
+		piggy, ok := value.(cadence.Struct)
+		if !ok {
+			return nil, fmt.Errorf("unexpected piggy %s", value)
+		}
+		piggies = append(piggies, decodePiggy(piggy))
+	}
+	return piggies, nil
+}
+
+// GetPiggyMetadata returns the metadata of a piggy, false when the piggy does not exist.
+func GetPiggyMetadata(ctx context.Context, client access.Client, e Environment, piggyID uint32) (map[string]string, bool, error) {
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, GenerateGetPiggyMetadata(e), []cadence.Value{cadence.NewUInt32(piggyID)})
+	if err != nil {
+		return nil, false, err
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected script result %s", result)
+	}
+	if optional.Value == nil {
+		return nil, false, nil
+	}
+	return decodeStringMap(optional.Value), true, nil
+}
+
+// GetPiggyNumDonations returns the number of donations minted for a piggy.
+func GetPiggyNumDonations(ctx context.Context, client access.Client, e Environment, piggyID uint32) (uint32, error) {
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, GenerateGetPiggyNumDonations(e), []cadence.Value{cadence.NewUInt32(piggyID)})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := result.(cadence.UInt32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected script result %s", result)
+	}
+	return uint32(count), nil
+}
+
+// GetTotalSupply returns the number of donation NFTs minted by the contract.
+func GetTotalSupply(ctx context.Context, client access.Client, e Environment) (uint64, error) {
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, GenerateGetTotalSupply(e), nil)
+	if err != nil {
+		return 0, err
+	}
+	supply, ok := result.(cadence.UInt64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected script result %s", result)
+	}
+	return uint64(supply), nil
+}
+
+// GetCollectionIDs returns the donation IDs in an account collection, false when the account has no collection.
+func GetCollectionIDs(ctx context.Context, client access.Client, e Environment, address flow.Address) ([]uint64, bool, error) {
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, GenerateGetCollectionIDs(e), []cadence.Value{cadence.NewAddress(address)})
+	if err != nil {
+		return nil, false, err
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected script result %s", result)
+	}
+	if optional.Value == nil {
+		return nil, false, nil
+	}
+	array, ok := optional.Value.(cadence.Array)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected script result %s", result)
+	}
+	ids := []uint64{}
+	for _, value := range array.Values {
+		if id, ok := value.(cadence.UInt64); ok {
+			ids = append(ids, uint64(id))
+		}
+	}
+	return ids, true, nil
+}
+
+// GetDonation returns the data of a donation borrowed from an account collection, nil when the account does not own it.
+func GetDonation(ctx context.Context, client access.Client, e Environment, address flow.Address, nftID uint64) (*DonationData, error) {
+	value, err := executeOptionalStruct(ctx, client, GenerateGetDonation(e), address, nftID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	fields := structFields(*value)
+	donation := &DonationData{ID: nftID}
+	if piggyID, ok := fields["piggyID"].(cadence.UInt32); ok {
+		donation.PiggyID = uint32(piggyID)
+	}
+	if serialNumber, ok := fields["serialNumber"].(cadence.UInt32); ok {
+		donation.SerialNumber = uint32(serialNumber)
+	}
+	if comment, ok := fields["donationComment"].(cadence.String); ok {
+		donation.DonationComment = string(comment)
+	}
+	return donation, nil
+}
+
+// GetDonationDisplay returns the Display view of a donation in an account collection, nil when the account does not own it.
+func GetDonationDisplay(ctx context.Context, client access.Client, e Environment, address flow.Address, nftID uint64) (*DisplayData, error) {
+	value, err := executeOptionalStruct(ctx, client, GenerateGetDonationDisplay(e), address, nftID)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return decodeDisplay(*value), nil
+}
+
+func executeOptionalStruct(ctx context.Context, client access.Client, script []byte, address flow.Address, nftID uint64) (*cadence.Struct, error) {
+	args := []cadence.Value{cadence.NewAddress(address), cadence.NewUInt64(nftID)}
+	result, err := client.ExecuteScriptAtLatestBlock(ctx, script, args)
+	if err != nil {
+		return nil, err
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok {
+		return nil, fmt.Errorf("unexpected script result %s", result)
+	}
+	if optional.Value == nil {
+		return nil, nil
+	}
+	value, ok := optional.Value.(cadence.Struct)
+	if !ok {
+		return nil, fmt.Errorf("unexpected script result %s", result)
+	}
+	return &value, nil
+}
+
+func decodeDisplay(value cadence.Struct) *DisplayData {
+	fields := structFields(value)
+	display := &DisplayData{}
+	if name, ok := fields["name"].(cadence.String); ok {
+		display.Name = string(name)
+	}
+	if description, ok := fields["description"].(cadence.String); ok {
+		display.Description = string(description)
+	}
+	if thumbnail, ok := fields["thumbnail"].(cadence.Struct); ok {
+		display.Thumbnail = fileURL(thumbnail)
+	}
+	return display
+}
+
+// fileURL resolves a MetadataViews.HTTPFile or MetadataViews.IPFSFile to a url.
+func fileURL(file cadence.Struct) string {
+	fields := structFields(file)
+	if url, ok := fields["url"].(cadence.String); ok {
+		return string(url)
+	}
+	cid, ok := fields["cid"].(cadence.String)
+	if !ok {
+		return ""
+	}
+	url := "ipfs://" + string(cid)
+	if path, ok := fields["path"].(cadence.Optional); ok && path.Value != nil {
+		if path, ok := path.Value.(cadence.String); ok {
+			url += "/" + string(path)
+		}
+	}
+	return url
+}
+
+// structFields returns the fields of a struct by identifier.
+func structFields(value cadence.Struct) map[string]cadence.Value {
+	fields := map[string]cadence.Value{}
+	if value.StructType == nil {
+		return fields
+	}
+	for i, field := range value.StructType.Fields {
+		if i < len(value.Fields) {
+			fields[field.Identifier] = value.Fields[i]
+		}
+	}
+	return fields
+}
+
+func decodePiggy(value cadence.Struct) PiggyData {
+	piggy := PiggyData{Metadata: map[string]string{}}
+	if value.StructType == nil {
+		return piggy
+	}
+	for i, field := range value.StructType.Fields {
+		if i >= len(value.Fields) {
+			break
+		}
+		switch field.Identifier {
+		case "piggyID":
+			if id, ok := value.Fields[i].(cadence.UInt32); ok {
+				piggy.ID = uint32(id)
+			}
+		case "metadata":
+			piggy.Metadata = decodeStringMap(value.Fields[i])
+		case "broken":
+			if broken, ok := value.Fields[i].(cadence.Bool); ok {
+				piggy.Broken = bool(broken)
+			}
+		case "collectedAmount":
+			if amount, ok := value.Fields[i].(cadence.UInt64); ok {
+				piggy.CollectedAmount = uint64(amount)
+			}
+		case "breakerRoyalty":
+			if royalty, ok := value.Fields[i].(cadence.UInt64); ok {
+				piggy.BreakerRoyalty = uint64(royalty)
+			}
+		}
+	}
+	return piggy
+}
+
+func decodeStringMap(value cadence.Value) map[string]string {
+	values := map[string]string{}
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return values
+	}
+	for _, pair := range dictionary.Pairs {
+		key, keyOk := pair.Key.(cadence.String)
+		element, elementOk := pair.Value.(cadence.String)
+		if keyOk && elementOk {
+			values[string(key)] = string(element)
+		}
+	}
+	return values
+}
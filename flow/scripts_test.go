@@ -0,0 +1,72 @@
+package flow
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+)
+
+func testStruct(identifier string, fields map[string]cadence.Value, order ...string) cadence.Struct {
+	structType := &cadence.StructType{QualifiedIdentifier: identifier}
+	values := []cadence.Value{}
+	for _, name := range order {
+		structType.Fields = append(structType.Fields, cadence.Field{Identifier: name})
+		values = append(values, fields[name])
+	}
+	return cadence.NewStruct(values).WithType(structType)
+}
+
+func TestDecodeDisplay(t *testing.T) {
+	name, _ := cadence.NewString("Donation number1")
+	description, _ := cadence.NewString("No reason")
+	url, _ := cadence.NewString("https://assets.gopiggy.com/media/1?width=256")
+	thumbnail := testStruct("MetadataViews.HTTPFile", map[string]cadence.Value{"url": url}, "url")
+	display := testStruct("MetadataViews.Display", map[string]cadence.Value{
+		"name":        name,
+		"description": description,
+		"thumbnail":   thumbnail,
+	}, "name", "description", "thumbnail")
+
+	assert.Equal(t, &DisplayData{
+		Name:        "Donation number1",
+		Description: "No reason",
+		Thumbnail:   "https://assets.gopiggy.com/media/1?width=256",
+	}, decodeDisplay(display))
+}
+
+func TestFileURL(t *testing.T) {
+	cid, _ := cadence.NewString("bafy")
+	path, _ := cadence.NewString("1.png")
+	ipfs := testStruct("MetadataViews.IPFSFile", map[string]cadence.Value{
+		"cid":  cid,
+		"path": cadence.NewOptional(path),
+	}, "cid", "path")
+	assert.Equal(t, "ipfs://bafy/1.png", fileURL(ipfs))
+
+	ipfs = testStruct("MetadataViews.IPFSFile", map[string]cadence.Value{
+		"cid":  cid,
+		"path": cadence.NewOptional(nil),
+	}, "cid", "path")
+	assert.Equal(t, "ipfs://bafy", fileURL(ipfs))
+}
+
+func TestDecodePiggy(t *testing.T) {
+	key, _ := cadence.NewString("Name")
+	value, _ := cadence.NewString("Trip")
+	piggy := testStruct("PiggyBanks.Piggy", map[string]cadence.Value{
+		"piggyID":         cadence.NewUInt32(3),
+		"metadata":        cadence.NewDictionary([]cadence.KeyValuePair{{Key: key, Value: value}}),
+		"broken":          cadence.NewBool(true),
+		"collectedAmount": cadence.NewUInt64(90),
+		"breakerRoyalty":  cadence.NewUInt64(10),
+	}, "piggyID", "metadata", "broken", "collectedAmount", "breakerRoyalty")
+
+	assert.Equal(t, PiggyData{
+		ID:              3,
+		Metadata:        map[string]string{"Name": "Trip"},
+		Broken:          true,
+		CollectedAmount: 90,
+		BreakerRoyalty:  10,
+	}, decodePiggy(piggy))
+}
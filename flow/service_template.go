@@ -3,16 +3,25 @@ package flow
 const (
 
 	// USER
-	setupAccountFilename = "blockchain/transactions/user/setup_account.cdc"
+	setupAccountFilename     = "transactions/user/setup_account.cdc"
+	transferDonationFilename = "transactions/user/transfer_donation.cdc"
 
 	// ADMIN
-	createPiggyFilename   = "blockchain/transactions/admin/create_piggy.cdc"
-	mintDonationFilename  = "blockchain/transactions/admin/mint_donation.cdc"
-	transferAdminFilename = "blockchain/transactions/admin/transfer_admin.cdc"
+	createPiggyFilename   = "transactions/admin/create_piggy.cdc"
+	mintDonationFilename  = "transactions/admin/mint_donation.cdc"
+	breakPiggyFilename    = "transactions/admin/break_piggy.cdc"
+	transferAdminFilename = "transactions/admin/transfer_admin.cdc"
 
 	// SCRIPTS
-	nextPiggyIDFilename    = "blockchain/transactions/scripts/get_nextPiggyID.cdc"
-	getTotalSupplyFilename = "blockchain/transactions/scripts/get_totalSupply.cdc"
+	nextPiggyIDFilename           = "transactions/scripts/get_nextPiggyID.cdc"
+	getTotalSupplyFilename        = "transactions/scripts/get_totalSupply.cdc"
+	hasDonationCollectionFilename = "transactions/scripts/has_donation_collection.cdc"
+	getAllPiggiesFilename         = "transactions/scripts/get_all_piggies.cdc"
+	getPiggyMetadataFilename      = "transactions/scripts/get_piggy_metadata.cdc"
+	getPiggyNumDonationsFilename  = "transactions/scripts/get_piggy_num_donations.cdc"
+	getCollectionIDsFilename      = "transactions/scripts/get_collection_ids.cdc"
+	getDonationFilename           = "transactions/scripts/get_donation.cdc"
+	getDonationDisplayFilename    = "transactions/scripts/get_donation_display.cdc"
 )
 
 func GenerateSetupAccount(env Environment) []byte {
@@ -21,6 +30,12 @@ func GenerateSetupAccount(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+func GenerateTransferDonation(env Environment) []byte {
+	code := MustAssetString(transferDonationFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 func GenerateCreatePiggy(env Environment) []byte {
 	code := MustAssetString(createPiggyFilename)
 
@@ -33,6 +48,12 @@ func GenerateMintDonation(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+func GenerateBreakPiggy(env Environment) []byte {
+	code := MustAssetString(breakPiggyFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 func GenerateTransferAdmin(env Environment) []byte {
 	code := MustAssetString(transferAdminFilename)
 
@@ -50,3 +71,45 @@ func GenerateGetTotalSupply(env Environment) []byte {
 
 	return []byte(replaceAddresses(code, env))
 }
+
+func GenerateHasDonationCollection(env Environment) []byte {
+	code := MustAssetString(hasDonationCollectionFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetAllPiggies(env Environment) []byte {
+	code := MustAssetString(getAllPiggiesFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetPiggyMetadata(env Environment) []byte {
+	code := MustAssetString(getPiggyMetadataFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetPiggyNumDonations(env Environment) []byte {
+	code := MustAssetString(getPiggyNumDonationsFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetCollectionIDs(env Environment) []byte {
+	code := MustAssetString(getCollectionIDsFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetDonation(env Environment) []byte {
+	code := MustAssetString(getDonationFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetDonationDisplay(env Environment) []byte {
+	code := MustAssetString(getDonationDisplayFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
@@ -91,8 +91,8 @@ func TestNetTest(t *testing.T) {
 		err = client.SendTransaction(ctx, *tx)
 		utils.LogAndPanicError(logger, err)
 
-		createMinterTxResp := utils.WaitForSeal(ctx, client, tx.ID())
-		utils.LogAndPanicError(logger, createMinterTxResp.Error)
+		_, err = utils.WaitForSeal(ctx, client, tx.ID())
+		utils.LogAndPanicError(logger, err)
 	})
 
 	t.Run("Get number of piggies..", func(t *testing.T) {
@@ -113,8 +113,8 @@ func TestNetTest(t *testing.T) {
 		err = client.SendTransaction(ctx, *tx)
 		utils.LogAndPanicError(logger, err)
 
-		createMinterTxResp := utils.WaitForSeal(ctx, client, tx.ID())
-		utils.LogAndPanicError(logger, createMinterTxResp.Error)
+		_, err = utils.WaitForSeal(ctx, client, tx.ID())
+		utils.LogAndPanicError(logger, err)
 	})
 
 	t.Run("Get number of donations..", func(t *testing.T) {
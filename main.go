@@ -1,10 +1,30 @@
 package main
 
 import (
+	"context"
+	"log"
+	"os"
+
 	"github.com/manubidegain/piggy-api/cmd/api"
+	"github.com/manubidegain/piggy-api/cmd/deploy"
+	"github.com/manubidegain/piggy-api/cmd/token"
 )
 
 func main() {
+	// piggy-api deploy [-profile dev|test|prod] [-dry-run] deploys or updates the PiggyBanks contract
+	if len(os.Args) > 1 && os.Args[1] == "deploy" {
+		if err := deploy.Run(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	// piggy-api token -uid <uid> [-roles admin,creator,donor] prints a token the dev profile accepts
+	if len(os.Args) > 1 && os.Args[1] == "token" {
+		if err := token.Run(os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	app := &api.App{}
 	app.Initialize()
 }
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"cloud.google.com/go/datastore"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+)
+
+// Datastore kind of the custodial account keys.
+const accountKind = "Account"
+
+// AccountKeyStore keeps the private keys of the custodial accounts.
+type AccountKeyStore interface {
+	Save(ctx context.Context, address string, publicKey string, privateKey string) error
+	Load(ctx context.Context, address string) (Readed, error)
+	Close() error
+}
+
+// OpenAccountKeyStore returns the store of the custodial keys of profile, Datastore with the keys
+// encrypted by Cloud KMS, or the memory store of the embedded emulator when it serves the profile.
+func OpenAccountKeyStore(profile string, projectConfig *configuration.ProjectConfig) (AccountKeyStore, error) {
+	if keys := embeddedAccountKeys(profile); keys != nil {
+		return keys, nil
+	}
+	client, err := SetupDataStoreClient(profile, projectConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &datastoreAccountKeys{client: client, profile: profile, projectConfig: projectConfig}, nil
+}
+
+type datastoreAccountKeys struct {
+	client        *datastore.Client
+	profile       string
+	projectConfig *configuration.ProjectConfig
+}
+
+func (s *datastoreAccountKeys) Save(ctx context.Context, address string, publicKey string, privateKey string) error {
+	entry, err := CreateNewEntry(address, publicKey, privateKey, s.projectConfig)
+	if err != nil {
+		return err
+	}
+	_, err = UploadValue(ctx, entry, accountKind, s.client)
+	return err
+}
+
+func (s *datastoreAccountKeys) Load(ctx context.Context, address string) (Readed, error) {
+	return GetValue(ctx, s.client, address, accountKind, s.profile, s.projectConfig)
+}
+
+func (s *datastoreAccountKeys) Close() error {
+	return s.client.Close()
+}
+
+// MemoryAccountKeys keeps the custodial keys in memory, for the accounts of an embedded emulator
+// that are gone when the process exits.
+type MemoryAccountKeys struct {
+	mu   sync.Mutex
+	keys map[string]Readed
+}
+
+func NewMemoryAccountKeys() *MemoryAccountKeys {
+	return &MemoryAccountKeys{keys: map[string]Readed{}}
+}
+
+func (s *MemoryAccountKeys) Save(ctx context.Context, address string, publicKey string, privateKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[address] = Readed{Address: address, PublicKey: publicKey, PrivateKey: privateKey}
+	return nil
+}
+
+func (s *MemoryAccountKeys) Load(ctx context.Context, address string) (Readed, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	readed, ok := s.keys[address]
+	if !ok {
+		return Readed{}, fmt.Errorf("no key stored for account %s", address)
+	}
+	return readed, nil
+}
+
+// Close keeps the keys, the store lives as long as the emulator.
+func (s *MemoryAccountKeys) Close() error {
+	return nil
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// embedded is the in-process emulator serving the dev profile instead of the one at grpc.EmulatorHost.
+var embedded struct {
+	sync.Mutex
+	client access.Client
+	keys   AccountKeyStore
+}
+
+// UseEmbeddedEmulator makes the dev profile connect to client, signing the service transactions
+// with the keys of address that signer holds and keeping the custodial keys in keys.
+func UseEmbeddedEmulator(ctx context.Context, client access.Client, address flow.Address, signer Signer, keys AccountKeyStore) error {
+	pool, err := NewKeyPool(ctx, client, address, signer)
+	if err != nil {
+		return err
+	}
+
+	embedded.Lock()
+	embedded.client = client
+	embedded.keys = keys
+	embedded.Unlock()
+
+	serviceKeyPoolsMu.Lock()
+	serviceKeyPools[Development] = pool
+	serviceKeyPoolsMu.Unlock()
+	return nil
+}
+
+func embeddedClient(profile string) access.Client {
+	if profile != Development {
+		return nil
+	}
+	embedded.Lock()
+	defer embedded.Unlock()
+	return embedded.client
+}
+
+func embeddedAccountKeys(profile string) AccountKeyStore {
+	if profile != Development {
+		return nil
+	}
+	embedded.Lock()
+	defer embedded.Unlock()
+	return embedded.keys
+}
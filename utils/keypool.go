@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// KeyPool hands out the proposal keys of an account, one transaction at a time per key,
+// and tracks their sequence numbers in memory so transactions can be sent in parallel.
+type KeyPool struct {
+	client  access.Client
+	address flow.Address
+	signer  Signer
+	size    int
+	keys    chan *flow.AccountKey
+}
+
+// ServiceKey is a proposal key taken from a KeyPool.
+type ServiceKey struct {
+	Address flow.Address
+	Key     *flow.AccountKey
+	Signer  Signer
+	pool    *KeyPool
+}
+
+// resyncTimeout bounds the lookup of a key on the chain, the key is out of its pool meanwhile.
+var resyncTimeout = 10 * time.Second
+
+var (
+	serviceKeyPools   = map[string]*KeyPool{}
+	serviceKeyPoolsMu sync.Mutex
+)
+
+// NewKeyPool loads every non revoked key of address that can be signed with signer.
+// The account can register the same public key several times to get more proposal keys.
+func NewKeyPool(ctx context.Context, client access.Client, address flow.Address, signer Signer) (*KeyPool, error) {
+	acc, err := client.GetAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	pool := &KeyPool{
+		client:  client,
+		address: address,
+		signer:  signer,
+		keys:    make(chan *flow.AccountKey, len(acc.Keys)),
+	}
+	for _, key := range acc.Keys {
+		if key.Revoked || key.HashAlgo != signer.HashAlgo() || !key.PublicKey.Equals(signer.PublicKey()) {
+			continue
+		}
+		pool.size++
+		pool.keys <- key
+	}
+	if pool.size == 0 {
+		return nil, fmt.Errorf("account %s has no key matching the configured signer", address)
+	}
+	return pool, nil
+}
+
+// Size returns the number of proposal keys in the pool.
+func (p *KeyPool) Size() int {
+	return p.size
+}
+
+// Acquire waits for a free key. It must be given back with Release.
+func (p *KeyPool) Acquire(ctx context.Context) (*ServiceKey, error) {
+	select {
+	case key := <-p.keys:
+		return &ServiceKey{Address: p.address, Key: key, Signer: p.signer, pool: p}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Sent records that a transaction proposed with the key reached the network, consuming its sequence number.
+func (k *ServiceKey) Sent() {
+	k.Key.SequenceNumber++
+}
+
+// Release gives the key back to its pool. err is the outcome of the transactions sent with the key,
+// when it shows the tracked sequence number is wrong the key is synced with the chain first.
+func (k *ServiceKey) Release(err error) {
+	if needsResync(err) {
+		k.resync()
+	}
+	k.pool.keys <- k.Key
+}
+
+// resync does not use the context of the transactions, it is usually done when they failed on expiry.
+func (k *ServiceKey) resync() {
+	ctx, cancel := context.WithTimeout(context.Background(), resyncTimeout)
+	defer cancel()
+	acc, err := k.pool.client.GetAccount(ctx, k.Address)
+	if err != nil {
+		return
+	}
+	for _, key := range acc.Keys {
+		if key.Index == k.Key.Index {
+			k.Key.SequenceNumber = key.SequenceNumber
+		}
+	}
+}
+
+// needsResync reports whether err comes from a transaction that did not consume the expected sequence number.
+func needsResync(err error) bool {
+	if err == nil {
+		return false
+	}
+	var expired *TransactionExpiredError
+	if errors.As(err, &expired) {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "sequence number") || strings.Contains(message, "invalid proposal key")
+}
+
+// AcquireServiceKey takes a free proposal key of the service account of the profile.
+// The pool is loaded from the chain on first use and shared by the whole process.
+func AcquireServiceKey(ctx context.Context, config *configuration.FlowConfig, profile string) (*ServiceKey, error) {
+	pool, err := serviceKeyPool(ctx, config, profile)
+	if err != nil {
+		return nil, err
+	}
+	return pool.Acquire(ctx)
+}
+
+func serviceKeyPool(ctx context.Context, config *configuration.FlowConfig, profile string) (*KeyPool, error) {
+	serviceKeyPoolsMu.Lock()
+	defer serviceKeyPoolsMu.Unlock()
+
+	if pool, ok := serviceKeyPools[profile]; ok {
+		return pool, nil
+	}
+	account, err := config.ServiceAccount(profile)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := NewServiceSigner(ctx, BuildConfig(profile).Signer, account)
+	if err != nil {
+		return nil, err
+	}
+	client, err := ConnectToFlow(profile, config)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := NewKeyPool(ctx, client, flow.HexToAddress(account.Address), signer)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	serviceKeyPools[profile] = pool
+	return pool, nil
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountClient answers GetAccount with a copy of account.
+type accountClient struct {
+	access.Client
+	account *flow.Account
+	// hang makes GetAccount wait for the context instead
+	hang bool
+}
+
+func (c *accountClient) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
+	if c.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	account := *c.account
+	account.Keys = nil
+	for _, key := range c.account.Keys {
+		copied := *key
+		account.Keys = append(account.Keys, &copied)
+	}
+	return &account, nil
+}
+
+func newTestKeyPool(t *testing.T) (*KeyPool, *accountClient) {
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, make([]byte, crypto.MinSeedLength))
+	require.NoError(t, err)
+	other, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, append(make([]byte, crypto.MinSeedLength-1), 1))
+	require.NoError(t, err)
+
+	client := &accountClient{account: &flow.Account{
+		Address: flow.ServiceAddress(flow.Emulator),
+		Keys: []*flow.AccountKey{
+			{Index: 0, PublicKey: privateKey.PublicKey(), HashAlgo: crypto.SHA3_256, SequenceNumber: 4},
+			{Index: 1, PublicKey: privateKey.PublicKey(), HashAlgo: crypto.SHA3_256, SequenceNumber: 9},
+			{Index: 2, PublicKey: privateKey.PublicKey(), HashAlgo: crypto.SHA3_256, Revoked: true},
+			{Index: 3, PublicKey: other.PublicKey(), HashAlgo: crypto.SHA3_256},
+			{Index: 4, PublicKey: privateKey.PublicKey(), HashAlgo: crypto.SHA2_256},
+		},
+	}}
+	signer, err := NewInMemorySigner(privateKey, crypto.SHA3_256)
+	require.NoError(t, err)
+	pool, err := NewKeyPool(context.Background(), client, client.account.Address, signer)
+	require.NoError(t, err)
+	return pool, client
+}
+
+func TestKeyPoolAcquire(t *testing.T) {
+	pool, _ := newTestKeyPool(t)
+	assert.Equal(t, 2, pool.Size())
+
+	first, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	second, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Key.Index, second.Key.Index)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.Acquire(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	sequence := first.Key.SequenceNumber
+	first.Sent()
+	first.Release(nil)
+	again, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, first.Key.Index, again.Key.Index)
+	assert.Equal(t, sequence+1, again.Key.SequenceNumber)
+}
+
+func TestKeyPoolResync(t *testing.T) {
+	pool, client := newTestKeyPool(t)
+
+	key, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	onChain := client.account.Keys[key.Key.Index].SequenceNumber
+	key.Sent()
+	key.Sent()
+	key.Release(&TransactionExpiredError{ID: flow.EmptyID})
+	assert.Equal(t, onChain, key.Key.SequenceNumber)
+
+	assert.True(t, needsResync(errors.New("invalid proposal key: sequence number mismatch")))
+	assert.False(t, needsResync(errors.New("cannot borrow Piggy")))
+	assert.False(t, needsResync(nil))
+}
+
+func TestKeyPoolResyncTimeout(t *testing.T) {
+	pool, client := newTestKeyPool(t)
+	timeout := resyncTimeout
+	resyncTimeout = 50 * time.Millisecond
+	defer func() { resyncTimeout = timeout }()
+
+	key, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	client.hang = true
+	key.Sent()
+	sequence := key.Key.SequenceNumber
+	key.Release(&TransactionExpiredError{ID: flow.EmptyID})
+
+	// the key is back in the pool, as tracked
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < pool.Size(); i++ {
+		again, err := pool.Acquire(ctx)
+		require.NoError(t, err)
+		if again.Key.Index == key.Key.Index {
+			assert.Equal(t, sequence, again.Key.SequenceNumber)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+const (
+	// Used when the caller context has no deadline of its own
+	defaultSealTimeout = 3 * time.Minute
+	sealInitialBackoff = 500 * time.Millisecond
+	sealMaxBackoff     = 8 * time.Second
+)
+
+// TransactionExpiredError is returned when a transaction expired before being sealed.
+type TransactionExpiredError struct {
+	ID flow.Identifier
+}
+
+func (e *TransactionExpiredError) Error() string {
+	return fmt.Sprintf("transaction %s expired before being sealed", e.ID)
+}
+
+// TransactionExecutionError is returned when a transaction was sealed with an execution error.
+type TransactionExecutionError struct {
+	ID     flow.Identifier
+	Result *flow.TransactionResult
+}
+
+func (e *TransactionExecutionError) Error() string {
+	return fmt.Sprintf("transaction %s failed: %s", e.ID, e.Result.Error.Error())
+}
+
+func (e *TransactionExecutionError) Unwrap() error {
+	return e.Result.Error
+}
+
+// TransactionRPCError is returned when the access node cannot be asked for the transaction result.
+type TransactionRPCError struct {
+	ID  flow.Identifier
+	Err error
+}
+
+func (e *TransactionRPCError) Error() string {
+	return fmt.Sprintf("cannot get result of transaction %s: %s", e.ID, e.Err.Error())
+}
+
+func (e *TransactionRPCError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForSeal polls the transaction result until it is sealed, backing off with jitter between polls.
+// It stops when ctx is done, or after defaultSealTimeout when ctx has no deadline.
+func WaitForSeal(ctx context.Context, c access.Client, id flow.Identifier) (*flow.TransactionResult, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultSealTimeout)
+		defer cancel()
+	}
+
+	backoff := sealInitialBackoff
+	for {
+		result, err := c.GetTransactionResult(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, &TransactionRPCError{ID: id, Err: err}
+		}
+
+		switch result.Status {
+		case flow.TransactionStatusSealed:
+			if result.Error != nil {
+				return result, &TransactionExecutionError{ID: id, Result: result}
+			}
+			return result, nil
+		case flow.TransactionStatusExpired:
+			return result, &TransactionExpiredError{ID: id}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(jitter(backoff)):
+		}
+		backoff *= 2
+		if backoff > sealMaxBackoff {
+			backoff = sealMaxBackoff
+		}
+	}
+}
+
+// jitter returns a random duration between half of d and d.
+func jitter(d time.Duration) time.Duration {
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resultClient answers GetTransactionResult with the queued results, repeating the last one.
+type resultClient struct {
+	access.Client
+	results []*flow.TransactionResult
+	err     error
+	calls   int
+}
+
+func (c *resultClient) GetTransactionResult(ctx context.Context, id flow.Identifier) (*flow.TransactionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	result := c.results[len(c.results)-1]
+	if c.calls < len(c.results) {
+		result = c.results[c.calls]
+	}
+	c.calls++
+	return result, nil
+}
+
+func TestWaitForSealSealed(t *testing.T) {
+	client := &resultClient{results: []*flow.TransactionResult{
+		{Status: flow.TransactionStatusPending},
+		{Status: flow.TransactionStatusSealed},
+	}}
+	result, err := WaitForSeal(context.Background(), client, flow.EmptyID)
+	require.NoError(t, err)
+	assert.Equal(t, flow.TransactionStatusSealed, result.Status)
+	assert.Equal(t, 2, client.calls)
+}
+
+func TestWaitForSealErrors(t *testing.T) {
+	executionErr := errors.New("cannot borrow Piggy")
+	client := &resultClient{results: []*flow.TransactionResult{{Status: flow.TransactionStatusSealed, Error: executionErr}}}
+	_, err := WaitForSeal(context.Background(), client, flow.EmptyID)
+	var execErr *TransactionExecutionError
+	assert.ErrorAs(t, err, &execErr)
+	assert.ErrorIs(t, err, executionErr)
+
+	client = &resultClient{results: []*flow.TransactionResult{{Status: flow.TransactionStatusExpired}}}
+	_, err = WaitForSeal(context.Background(), client, flow.EmptyID)
+	var expiredErr *TransactionExpiredError
+	assert.ErrorAs(t, err, &expiredErr)
+
+	rpcErr := errors.New("connection refused")
+	client = &resultClient{err: rpcErr}
+	_, err = WaitForSeal(context.Background(), client, flow.EmptyID)
+	var rpcFailure *TransactionRPCError
+	assert.ErrorAs(t, err, &rpcFailure)
+	assert.ErrorIs(t, err, rpcErr)
+}
+
+func TestWaitForSealDeadline(t *testing.T) {
+	client := &resultClient{results: []*flow.TransactionResult{{Status: flow.TransactionStatusPending}}}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := WaitForSeal(ctx, client, flow.EmptyID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
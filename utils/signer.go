@@ -0,0 +1,241 @@
+package utils
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/cloudkms"
+	"golang.org/x/crypto/scrypt"
+	"google.golang.org/api/option"
+)
+
+const (
+	MemorySigner   = "memory"
+	KeystoreSigner = "keystore"
+	KMSSigner      = "kms"
+
+	// KeystorePassphraseEnv holds the passphrase of the keystore file of the service account.
+	KeystorePassphraseEnv = "FLOW_KEYSTORE_PASSPHRASE"
+)
+
+// Signer signs transactions with an account key. HashAlgo is the hash algorithm
+// the key has to be registered with on chain for the signatures to be valid.
+type Signer interface {
+	crypto.Signer
+	HashAlgo() crypto.HashAlgorithm
+}
+
+type inMemorySigner struct {
+	crypto.InMemorySigner
+	hashAlgo crypto.HashAlgorithm
+}
+
+func (s inMemorySigner) HashAlgo() crypto.HashAlgorithm {
+	return s.hashAlgo
+}
+
+// NewInMemorySigner signs with a private key held by the process, only meant for the emulator.
+func NewInMemorySigner(privateKey crypto.PrivateKey, hashAlgo crypto.HashAlgorithm) (Signer, error) {
+	signer, err := crypto.NewInMemorySigner(privateKey, hashAlgo)
+	if err != nil {
+		return nil, err
+	}
+	// Decoded ECDSA keys only get their public point when it is first asked for, and signing needs it.
+	privateKey.PublicKey()
+	return inMemorySigner{InMemorySigner: signer, hashAlgo: hashAlgo}, nil
+}
+
+// Keystore is a private key encrypted with AES-GCM under a scrypt derived passphrase key.
+type Keystore struct {
+	SignatureAlgorithm string `json:"signature_algorithm"`
+	HashAlgorithm      string `json:"hash_algorithm"`
+	Salt               string `json:"salt"`
+	N                  int    `json:"n"`
+	R                  int    `json:"r"`
+	P                  int    `json:"p"`
+	Nonce              string `json:"nonce"`
+	Ciphertext         string `json:"ciphertext"`
+}
+
+const (
+	keystoreN      = 1 << 15
+	keystoreR      = 8
+	keystoreP      = 1
+	keystoreKeyLen = 32
+)
+
+// EncryptKeystore encrypts privateKey with passphrase.
+func EncryptKeystore(privateKey crypto.PrivateKey, hashAlgo crypto.HashAlgorithm, passphrase string) (*Keystore, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	keystore := &Keystore{
+		SignatureAlgorithm: privateKey.Algorithm().String(),
+		HashAlgorithm:      hashAlgo.String(),
+		Salt:               hex.EncodeToString(salt),
+		N:                  keystoreN,
+		R:                  keystoreR,
+		P:                  keystoreP,
+	}
+	aead, err := keystore.cipher(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	keystore.Nonce = hex.EncodeToString(nonce)
+	keystore.Ciphertext = hex.EncodeToString(aead.Seal(nil, nonce, privateKey.Encode(), nil))
+	return keystore, nil
+}
+
+// Decrypt returns the private key of the keystore, failing when the passphrase is wrong.
+func (k *Keystore) Decrypt(passphrase string) (crypto.PrivateKey, error) {
+	aead, err := k.cipher(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(k.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := hex.DecodeString(k.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("keystore: wrong passphrase or corrupted file")
+	}
+	return crypto.DecodePrivateKey(crypto.StringToSignatureAlgorithm(k.SignatureAlgorithm), encoded)
+}
+
+func (k *Keystore) cipher(passphrase string) (cipher.AEAD, error) {
+	salt, err := hex.DecodeString(k.Salt)
+	if err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, k.N, k.R, k.P, keystoreKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// WriteKeystore encrypts privateKey with passphrase into the file at path.
+func WriteKeystore(path string, privateKey crypto.PrivateKey, hashAlgo crypto.HashAlgorithm, passphrase string) error {
+	keystore, err := EncryptKeystore(privateKey, hashAlgo, passphrase)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(keystore, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// NewKeystoreSigner decrypts the keystore file at path and signs with its key.
+func NewKeystoreSigner(path string, passphrase string) (Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keystore Keystore
+	if err := json.Unmarshal(data, &keystore); err != nil {
+		return nil, fmt.Errorf("keystore: cannot read %s: %w", path, err)
+	}
+	privateKey, err := keystore.Decrypt(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewInMemorySigner(privateKey, crypto.StringToHashAlgorithm(keystore.HashAlgorithm))
+}
+
+type kmsSigner struct {
+	*cloudkms.Signer
+	hashAlgo crypto.HashAlgorithm
+}
+
+func (s kmsSigner) HashAlgo() crypto.HashAlgorithm {
+	return s.hashAlgo
+}
+
+// NewKMSSigner signs with a Cloud KMS asymmetric key version, the private key never leaves KMS.
+// resourceID is the full name of the key version.
+func NewKMSSigner(ctx context.Context, resourceID string, opts ...option.ClientOption) (Signer, error) {
+	key, err := cloudkms.KeyFromResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := cloudkms.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	_, hashAlgo, err := client.GetPublicKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	// The signer keeps the context for every signature, so it must outlive the request building it.
+	signer, err := client.SignerForKey(context.Background(), key)
+	if err != nil {
+		return nil, err
+	}
+	return kmsSigner{Signer: signer, hashAlgo: hashAlgo}, nil
+}
+
+// NewServiceSigner builds the signer of the service account selected by signerConfig.
+// Without configuration the key of the account in flow.json is used, a hex or a google-kms key.
+func NewServiceSigner(ctx context.Context, signerConfig *configuration.SignerConfig, account configuration.FlowAccount, opts ...option.ClientOption) (Signer, error) {
+	if signerConfig == nil {
+		signerConfig = &configuration.SignerConfig{}
+	}
+	signerType := signerConfig.Type
+	if signerType == "" && account.Key.Type == configuration.GoogleKMSKey {
+		signerType = KMSSigner
+	}
+	switch signerType {
+	case MemorySigner, "":
+		sigAlgo := crypto.ECDSA_P256
+		if account.Key.SignatureAlgorithm != "" {
+			sigAlgo = crypto.StringToSignatureAlgorithm(account.Key.SignatureAlgorithm)
+		}
+		privateKey, err := crypto.DecodePrivateKeyHex(sigAlgo, strings.TrimPrefix(account.Key.PrivateKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding privateKey: %w", err)
+		}
+		hashAlgo := crypto.SHA3_256
+		if signerConfig.HashAlgorithm != "" {
+			hashAlgo = crypto.StringToHashAlgorithm(signerConfig.HashAlgorithm)
+		} else if account.Key.HashAlgorithm != "" {
+			hashAlgo = crypto.StringToHashAlgorithm(account.Key.HashAlgorithm)
+		}
+		return NewInMemorySigner(privateKey, hashAlgo)
+	case KeystoreSigner:
+		return NewKeystoreSigner(signerConfig.KeystorePath, os.Getenv(KeystorePassphraseEnv))
+	case KMSSigner:
+		resourceID := signerConfig.KMSKey
+		if resourceID == "" {
+			resourceID = account.Key.ResourceID
+		}
+		return NewKMSSigner(ctx, resourceID, opts...)
+	default:
+		return nil, fmt.Errorf("unknown signer type %q", signerConfig.Type)
+	}
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/manubidegain/piggy-api/cmd/api/configuration"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const fakeKMSKey = "projects/piggy/locations/global/keyRings/flow/cryptoKeys/service/cryptoKeyVersions/1"
+
+// fakeKMS serves the two KMS calls a signer needs with a P-256 key held in memory.
+type fakeKMS struct {
+	kmspb.UnimplementedKeyManagementServiceServer
+	key *ecdsa.PrivateKey
+}
+
+func (f *fakeKMS) GetPublicKey(ctx context.Context, req *kmspb.GetPublicKeyRequest) (*kmspb.PublicKey, error) {
+	der, err := x509.MarshalPKIXPublicKey(&f.key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &kmspb.PublicKey{
+		Name:      req.Name,
+		Algorithm: kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256,
+		Pem:       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}, nil
+}
+
+func (f *fakeKMS) AsymmetricSign(ctx context.Context, req *kmspb.AsymmetricSignRequest) (*kmspb.AsymmetricSignResponse, error) {
+	digest := sha256.Sum256(req.Data)
+	signature, err := ecdsa.SignASN1(rand.Reader, f.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	return &kmspb.AsymmetricSignResponse{Name: req.Name, Signature: signature}, nil
+}
+
+func startFakeKMS(t *testing.T) []option.ClientOption {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	kmspb.RegisterKeyManagementServiceServer(server, &fakeKMS{key: key})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return []option.ClientOption{
+		option.WithEndpoint(listener.Addr().String()),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	}
+}
+
+func assertSigns(t *testing.T, signer Signer) {
+	message := []byte("piggy transaction envelope")
+	signature, err := signer.Sign(message)
+	require.NoError(t, err)
+	hasher, err := crypto.NewHasher(signer.HashAlgo())
+	require.NoError(t, err)
+	valid, err := signer.PublicKey().Verify(signature, message, hasher)
+	require.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestKMSSigner(t *testing.T) {
+	opts := startFakeKMS(t)
+	signer, err := NewServiceSigner(context.Background(), &configuration.SignerConfig{Type: KMSSigner, KMSKey: fakeKMSKey}, configuration.FlowAccount{}, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.SHA2_256, signer.HashAlgo())
+	assertSigns(t, signer)
+
+	_, err = NewKMSSigner(context.Background(), "not-a-key-version", opts...)
+	assert.Error(t, err)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, make([]byte, crypto.MinSeedLength))
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "service.keystore")
+	require.NoError(t, WriteKeystore(path, privateKey, crypto.SHA3_256, "piggy"))
+
+	t.Setenv(KeystorePassphraseEnv, "piggy")
+	signer, err := NewServiceSigner(context.Background(), &configuration.SignerConfig{Type: KeystoreSigner, KeystorePath: path}, configuration.FlowAccount{})
+	require.NoError(t, err)
+	assert.True(t, signer.PublicKey().Equals(privateKey.PublicKey()))
+	assert.Equal(t, crypto.SHA3_256, signer.HashAlgo())
+	assertSigns(t, signer)
+
+	_, err = NewKeystoreSigner(path, "wrong")
+	assert.Error(t, err)
+}
+
+func TestMemorySigner(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, make([]byte, crypto.MinSeedLength))
+	require.NoError(t, err)
+	account := configuration.FlowAccount{Key: configuration.FlowAccountKey{Type: configuration.HexKey, PrivateKey: privateKey.String()[2:]}}
+
+	signer, err := NewServiceSigner(context.Background(), nil, account)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.SHA3_256, signer.HashAlgo())
+	assertSigns(t, signer)
+
+	_, err = NewServiceSigner(context.Background(), &configuration.SignerConfig{Type: "vault"}, account)
+	assert.Error(t, err)
+}
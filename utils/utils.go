@@ -6,7 +6,6 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,7 +13,6 @@ import (
 	"net/http"
 	"os"
 	"strings"
-	"time"
 
 	"cloud.google.com/go/pubsub"
 	"github.com/gin-gonic/gin"
@@ -26,6 +24,7 @@ import (
 	"github.com/onflow/flow-go-sdk/access"
 	"github.com/onflow/flow-go-sdk/access/grpc"
 	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/client"
 )
 
@@ -61,27 +60,6 @@ func BuildConfig(profile string) *configuration.Config {
 
 }
 
-func WaitForSeal(ctx context.Context, c access.Client, id flow.Identifier) *flow.TransactionResult {
-	result, err := c.GetTransactionResult(ctx, id)
-	if err != nil {
-		msg := "Cannot get transaction result" + err.Error()
-		panic(msg)
-	}
-	fmt.Printf("Waiting for transaction %s to be sealed...\n", id)
-
-	for result.Status != flow.TransactionStatusSealed {
-		time.Sleep(time.Second)
-		fmt.Print(".")
-		result, err = c.GetTransactionResult(ctx, id)
-		if err != nil {
-			msg := "Cannot get transaction result" + err.Error()
-			panic(msg)
-		}
-	}
-	fmt.Printf("Transaction %s sealed\n", id)
-	return result
-}
-
 func PrintTransaction(ctx context.Context, c access.Client, id flow.Identifier, log *log.Logger) *flow.Transaction {
 	result, err := c.GetTransaction(ctx, id)
 	LogAndPanicError(log, err)
@@ -89,58 +67,46 @@ func PrintTransaction(ctx context.Context, c access.Client, id flow.Identifier,
 	return result
 }
 
-func GetReferenceBlockId(flowClient access.Client, log *log.Logger) flow.Identifier {
+func GetReferenceBlockId(flowClient access.Client, log *log.Logger) (flow.Identifier, error) {
 	block, err := flowClient.GetLatestBlock(context.Background(), true)
-	LogAndPanicError(log, err)
-
-	return block.ID
+	if err != nil {
+		return flow.EmptyID, HandleAndLogError(log, err)
+	}
+	return block.ID, nil
 }
 
-func GetAccount(ctx *gin.Context, client access.Client, address string) *flow.Account {
+// GetAccount returns the account at address, an error when it cannot be fetched or there is none.
+func GetAccount(ctx context.Context, client access.Client, address string) (*flow.Account, error) {
 	addr := flow.HexToAddress(address)
 	account, err := client.GetAccount(ctx, addr)
 	if err != nil {
-		msg := "error getting account" + err.Error()
-		panic(msg)
+		return nil, fmt.Errorf("error getting account %s: %w", addr.Hex(), err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("account %s not found", addr.Hex())
 	}
-	return account
+	return account, nil
 }
 
+// ConnectToFlow connects to the access node of the network of profile in flow.json,
+// or to the embedded emulator when it serves the profile.
 func ConnectToFlow(profile string, flowConfig *configuration.FlowConfig) (access.Client, error) {
-	if profile == "dev" {
-		flow, err := grpc.NewClient(grpc.EmulatorHost)
-		if err != nil {
-			panic("failed to establish connection with the Emulator")
-		}
-		return flow, nil
+	if client := embeddedClient(profile); client != nil {
+		return client, nil
 	}
-
-	if profile == "test" {
-
-		flow, err := grpc.NewClient(grpc.TestnetHost)
-		if err != nil {
-			panic("failed to establish connection with the Testnet network")
-		}
-		return flow, nil
+	host, err := flowConfig.Host(profile)
+	if err != nil {
+		return nil, err
 	}
-
-	if profile == "prod" {
-		flow, err := grpc.NewClient(grpc.MainnetHost)
-		if err != nil {
-			panic("failed to establish connection with the Mainnet network")
-		}
-		return flow, nil
+	flow, err := grpc.NewClient(host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to establish connection with %s: %w", host, err)
 	}
-
-	return nil, errors.New("profile is neccessary for connection")
-
+	return flow, nil
 }
 
-func CloseConnection(client access.Client) {
-	err := client.Close()
-	if err != nil {
-		panic(err)
-	}
+func CloseConnection(client access.Client) error {
+	return client.Close()
 }
 
 func HandleAndLogError(log *log.Logger, err error) error {
@@ -269,28 +235,6 @@ func printAllData(dataMap map[string]interface{}) {
 	}
 }
 
-func GetServiceAccount(flowClient access.Client, config *configuration.FlowConfig, profile string) (flow.Address, *flow.AccountKey, crypto.Signer) {
-	// Handle this with secrets for PROD
-	account := getServiceAccount(config, profile)
-	fmt.Printf(account.Address)
-	fmt.Printf(account.Key)
-	privateKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, account.Key)
-	if err != nil {
-		msg := "error decoding privateKey" + err.Error()
-		panic(msg)
-	}
-
-	addr := flow.HexToAddress(account.Address)
-	acc, err := flowClient.GetAccount(context.Background(), addr)
-	if err != nil {
-		msg := "error getting service account" + err.Error()
-		panic(msg)
-	}
-	accountKey := acc.Keys[0]
-	signer, _ := crypto.NewInMemorySigner(privateKey, accountKey.HashAlgo)
-	return addr, accountKey, signer
-}
-
 // RandomPrivateKey returns a randomly generated ECDSA P-256 private key.
 func RandomPrivateKey(log *log.Logger) crypto.PrivateKey {
 	seed := make([]byte, crypto.MinSeedLength)
@@ -303,23 +247,6 @@ func RandomPrivateKey(log *log.Logger) crypto.PrivateKey {
 	return privateKey
 }
 
-func getServiceAccount(config *configuration.FlowConfig, profile string) configuration.FlowServiceAccount {
-	if profile == "dev" {
-		return config.Accounts.Emulator
-	}
-
-	if profile == "test" {
-
-		return config.Accounts.Testnet
-	}
-
-	if profile == "prod" {
-		return config.Accounts.Mainnet
-	}
-
-	return configuration.FlowServiceAccount{}
-}
-
 // Needs to get this from our blockchain-api
 var mintTokensToAccountTemplate = `
 import FungibleToken from 0x%s
@@ -361,12 +288,21 @@ func fundAccount(flowClient access.Client, config *configuration.FlowConfig, add
 
 // Make the same for testnet and mainnet
 func fundAccountInEmulator(flowClient access.Client, config *configuration.FlowConfig, address flow.Address, amount float64, profile string, log *log.Logger) {
-	serviceAcctAddr, serviceAcctKey, serviceSigner := GetServiceAccount(flowClient, config, profile)
+	ctx := context.Background()
+	serviceKey, err := AcquireServiceKey(ctx, config, profile)
+	LogAndPanicError(log, err)
+	defer func() { serviceKey.Release(err) }()
+	serviceAcctAddr, serviceAcctKey := serviceKey.Address, serviceKey.Key
 
-	referenceBlockID := GetReferenceBlockId(flowClient, log)
+	referenceBlockID, err := GetReferenceBlockId(flowClient, log)
+	LogAndPanicError(log, err)
 
-	fungibleTokenAddress := flow.HexToAddress(config.Contracts["FungibleToken"])
-	flowTokenAddress := flow.HexToAddress(config.Contracts["FlowToken"])
+	fungibleToken, err := config.ContractAddress(profile, "FungibleToken")
+	LogAndPanicError(log, err)
+	flowToken, err := config.ContractAddress(profile, "FlowToken")
+	LogAndPanicError(log, err)
+	fungibleTokenAddress := flow.HexToAddress(fungibleToken)
+	flowTokenAddress := flow.HexToAddress(flowToken)
 
 	recipient := cadence.NewAddress(address)
 	uintAmount := uint64(amount * sema.Fix64Factor)
@@ -382,15 +318,15 @@ func fundAccountInEmulator(flowClient access.Client, config *configuration.FlowC
 			SetReferenceBlockID(referenceBlockID).
 			SetPayer(serviceAcctAddr)
 
-	err := fundAccountTx.SignEnvelope(serviceAcctAddr, serviceAcctKey.Index, serviceSigner)
+	err = fundAccountTx.SignEnvelope(serviceAcctAddr, serviceAcctKey.Index, serviceKey.Signer)
 	LogAndPanicError(log, err)
 
-	ctx := context.Background()
 	err = flowClient.SendTransaction(ctx, *fundAccountTx)
 	LogAndPanicError(log, err)
+	serviceKey.Sent()
 
-	result := WaitForSeal(ctx, flowClient, fundAccountTx.ID())
-	LogAndPanicError(log, result.Error)
+	_, err = WaitForSeal(ctx, flowClient, fundAccountTx.ID())
+	LogAndPanicError(log, err)
 }
 
 func ReadFile(path string, log *log.Logger) string {
@@ -469,7 +405,20 @@ func SetupStripe() *client.API {
 	stripeKey := os.Getenv("STRIPE_API_KEY")
 
 	sc := &client.API{}
-	sc.Init(stripeKey, nil)
+	sc.Init(stripeKey, StripeBackends(os.Getenv("STRIPE_API_URL")))
 	return sc
 
 }
+
+// StripeBackends points the Stripe API backend to url, used to run against a local stub.
+// Returns nil when url is empty so the client falls back to the default Stripe backends.
+func StripeBackends(url string) *stripe.Backends {
+	if url == "" {
+		return nil
+	}
+	return &stripe.Backends{
+		API:     stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{URL: url}),
+		Connect: stripe.GetBackend(stripe.ConnectBackend),
+		Uploads: stripe.GetBackend(stripe.UploadsBackend),
+	}
+}